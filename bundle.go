@@ -0,0 +1,246 @@
+package explore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+var (
+	errUnknownBundle            = errors.New("unknown bundle")
+	errConflictingRegistrations = errors.New("conflicting registrations")
+)
+
+const (
+	bundlesHelpShort = "List extension bundles"
+	bundlesHelpLong  = `List the extension bundles defined in the configuration file.
+
+A bundle is a named set of extensions, e.g. "observability" for the dashboard,
+prometheus and opentelemetry extensions. Bundles are defined in the "bundles"
+property of the configuration file, members are referenced by catalog name or
+module path:
+
+  {
+    "bundles": {
+      "observability": ["xk6-dashboard", "github.com/grafana/xk6-output-prometheus"]
+    }
+  }
+
+The configuration file is read from explore.json in the k6 configuration
+directory, or from the path given in the K6_EXPLORE_CONFIG environment variable,
+so a single file can be shared across a team.
+
+Use the --bundle flag of the explore command to list the extensions of a bundle.
+`
+	bundlesHelpExample = `
+# List the bundles:
+k6 x explore bundles
+
+# List the extensions of the observability bundle:
+k6 x explore --bundle observability
+`
+
+	bundlesHeader = "BUNDLE\tEXTENSIONS\n"
+)
+
+// newBundlesCommand creates the "bundles" subcommand of explore.
+func newBundlesCommand(gs *state.GlobalState) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "bundles",
+		Short:   bundlesHelpShort,
+		Long:    bundlesHelpLong,
+		Example: bundlesHelpExample,
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cfg, err := loadConfig(gs)
+			if err != nil {
+				return err
+			}
+
+			if asJSON {
+				return outputBundlesJSON(gs, cfg.Bundles)
+			}
+
+			return outputBundles(gs, cfg.Bundles)
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+
+	return cmd
+}
+
+func outputBundlesJSON(gs *state.GlobalState, bundles map[string][]string) error {
+	if bundles == nil {
+		bundles = map[string][]string{}
+	}
+
+	encoder := json.NewEncoder(gs.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(bundles)
+}
+
+func outputBundles(gs *state.GlobalState, bundles map[string][]string) error {
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	_, _ = w.Write([]byte(bundlesHeader))
+
+	names := make([]string, 0, len(bundles))
+	for name := range bundles {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		_, _ = w.Write([]byte(name + "\t" + strings.Join(bundles[name], ", ") + "\n"))
+	}
+
+	return w.Flush()
+}
+
+// expandBundles returns the part of the catalog which belongs to the named bundles.
+// Members missing from the catalog are reported as warnings, unknown bundle names as errors.
+func expandBundles(
+	gs *state.GlobalState,
+	catalog map[string]*extension,
+	bundles map[string][]string,
+	names []string,
+) (map[string]*extension, error) {
+	expanded := make(map[string]*extension)
+
+	for _, name := range names {
+		members, found := bundles[name]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", errUnknownBundle, name)
+		}
+
+		for _, member := range members {
+			key, ext := lookupExtension(catalog, member)
+			if ext == nil {
+				gs.Logger.Warnf("bundle %s: extension %s not found in the catalog", name, member)
+
+				continue
+			}
+
+			expanded[key] = ext
+		}
+	}
+
+	return expanded, nil
+}
+
+// lookupExtension finds an extension by catalog name or module path. Exact matches win,
+// then names and module paths are compared after normalization, see normalizeModule.
+// When several extensions match, e.g. a module listed under two names, the first catalog
+// name in sort order wins, so the result does not depend on the map iteration order.
+func lookupExtension(catalog map[string]*extension, name string) (string, *extension) {
+	if ext, found := catalog[name]; found {
+		return name, ext
+	}
+
+	keys := slices.Sorted(maps.Keys(catalog))
+
+	for _, key := range keys {
+		if catalog[key].Module == name {
+			return key, catalog[key]
+		}
+	}
+
+	query := normalizeModule(name)
+
+	for _, key := range keys {
+		if strings.EqualFold(key, query) || normalizeModule(catalog[key].Module) == query {
+			return key, catalog[key]
+		}
+	}
+
+	return "", nil
+}
+
+// normalizeModule normalizes a module path as copied from a browser or a git remote:
+// it is lowercased, the URL scheme, a trailing ".git" and trailing slashes are removed,
+// scp-style remotes (git@github.com:grafana/xk6-faker.git) are turned into paths, and
+// "github.com/" is assumed when the host is missing (e.g. "grafana/xk6-faker").
+func normalizeModule(module string) string {
+	module = strings.ToLower(strings.TrimSpace(module))
+
+	if remote, found := strings.CutPrefix(module, "git@"); found {
+		module = strings.Replace(remote, ":", "/", 1)
+	}
+
+	for _, scheme := range []string{"https://", "http://"} {
+		module = strings.TrimPrefix(module, scheme)
+	}
+
+	module = strings.TrimSuffix(strings.TrimRight(module, "/"), ".git")
+
+	if host, _, found := strings.Cut(module, "/"); found && !strings.Contains(host, ".") {
+		module = "github.com/" + module
+	}
+
+	return module
+}
+
+// registration is a name an extension claims in k6: a JavaScript import path,
+// an output name or a subcommand name.
+type registration struct {
+	kind string
+	name string
+}
+
+// detectConflicts reports the registrations claimed by more than one of the selected
+// extensions: imports, outputs, subcommands and the names of the other capabilities, e.g.
+// two secret sources of the same name. Such extensions cannot be built into the same k6
+// binary, so it is better to explain the conflict up front than to let the build fail later.
+func detectConflicts(selected map[string]*extension) error {
+	owners := make(map[registration][]string)
+
+	claim := func(key string, kind string, names []string) {
+		for _, name := range names {
+			reg := registration{kind: kind, name: name}
+			owners[reg] = append(owners[reg], key)
+		}
+	}
+
+	for key, ext := range selected {
+		claim(key, "import", ext.Imports)
+		claim(key, "output", ext.Outputs)
+		claim(key, "subcommand", ext.Subcommands)
+
+		for kind, names := range ext.Capabilities {
+			claim(key, kind, names)
+		}
+	}
+
+	conflicts := make([]string, 0)
+
+	for reg, keys := range owners {
+		if len(keys) < 2 {
+			continue
+		}
+
+		slices.Sort(keys)
+
+		conflicts = append(conflicts, fmt.Sprintf("%s %q is registered by %s", reg.kind, reg.name, strings.Join(keys, ", ")))
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	slices.Sort(conflicts)
+
+	return fmt.Errorf("%w, these extensions cannot be built into the same k6 binary:\n  %s",
+		errConflictingRegistrations, strings.Join(conflicts, "\n  "))
+}
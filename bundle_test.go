@@ -0,0 +1,324 @@
+package explore
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestExpandBundles(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker": {
+			Module:  "github.com/grafana/xk6-faker",
+			Imports: []string{"k6/x/faker"},
+		},
+		"xk6-dashboard": {
+			Module:      "github.com/grafana/xk6-dashboard",
+			Subcommands: []string{"dashboard"},
+		},
+		"xk6-output-prometheus": {
+			Module:  "github.com/grafana/xk6-output-prometheus",
+			Outputs: []string{"prometheus"},
+		},
+	}
+
+	bundles := map[string][]string{
+		"observability": {"xk6-dashboard", "github.com/grafana/xk6-output-prometheus"},
+		"data":          {"xk6-faker", "xk6-missing"},
+	}
+
+	tests := []struct {
+		name    string
+		names   []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "by catalog name and module path",
+			names: []string{"observability"},
+			want:  []string{"xk6-dashboard", "xk6-output-prometheus"},
+		},
+		{
+			name:  "missing members are skipped",
+			names: []string{"data"},
+			want:  []string{"xk6-faker"},
+		},
+		{
+			name:  "multiple bundles",
+			names: []string{"observability", "data"},
+			want:  []string{"xk6-dashboard", "xk6-faker", "xk6-output-prometheus"},
+		},
+		{
+			name:    "unknown bundle",
+			names:   []string{"unknown"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := cmdtests.NewGlobalTestState(t)
+
+			got, err := expandBundles(ts.GlobalState, catalog, bundles, tt.names)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errUnknownBundle)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			keys := make([]string, 0, len(got))
+			for key := range got {
+				keys = append(keys, key)
+			}
+
+			require.ElementsMatch(t, tt.want, keys)
+		})
+	}
+}
+
+func TestOutputBundles(t *testing.T) {
+	t.Parallel()
+
+	bundles := map[string][]string{
+		"observability": {"xk6-dashboard", "xk6-output-prometheus"},
+		"data":          {"xk6-faker"},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputBundles(ts.GlobalState, bundles))
+	require.Equal(t,
+		"BUNDLE         EXTENSIONS\n"+
+			"data           xk6-faker\n"+
+			"observability  xk6-dashboard, xk6-output-prometheus\n",
+		ts.Stdout.String(),
+	)
+
+	ts = cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputBundlesJSON(ts.GlobalState, bundles))
+
+	var result map[string][]string
+
+	require.NoError(t, json.Unmarshal(ts.Stdout.Bytes(), &result))
+	require.Equal(t, bundles, result)
+}
+
+func TestDetectConflicts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		selected map[string]*extension
+		wantErr  []string
+	}{
+		{
+			name: "no conflicts",
+			selected: map[string]*extension{
+				"xk6-faker":     {Imports: []string{"k6/x/faker"}},
+				"xk6-dashboard": {Subcommands: []string{"dashboard"}},
+			},
+		},
+		{
+			name: "same output name",
+			selected: map[string]*extension{
+				"xk6-output-prometheus": {Outputs: []string{"prometheus"}},
+				"xk6-prometheus":        {Outputs: []string{"prometheus"}},
+			},
+			wantErr: []string{`output "prometheus" is registered by xk6-output-prometheus, xk6-prometheus`},
+		},
+		{
+			name: "same subcommand and import",
+			selected: map[string]*extension{
+				"xk6-dashboard": {Subcommands: []string{"dashboard"}, Imports: []string{"k6/x/dashboard"}},
+				"xk6-fork":      {Subcommands: []string{"dashboard"}, Imports: []string{"k6/x/dashboard"}},
+			},
+			wantErr: []string{
+				`import "k6/x/dashboard" is registered by xk6-dashboard, xk6-fork`,
+				`subcommand "dashboard" is registered by xk6-dashboard, xk6-fork`,
+			},
+		},
+		{
+			name: "same capability name",
+			selected: map[string]*extension{
+				"xk6-secrets-vault": {Capabilities: map[string][]string{"secret-source": {"vault"}}},
+				"xk6-vault":         {Capabilities: map[string][]string{"secret-source": {"vault"}}},
+			},
+			wantErr: []string{`secret-source "vault" is registered by xk6-secrets-vault, xk6-vault`},
+		},
+		{
+			name: "same name of different capabilities",
+			selected: map[string]*extension{
+				"xk6-secrets-vault": {Capabilities: map[string][]string{"secret-source": {"vault"}}},
+				"xk6-output-vault":  {Outputs: []string{"vault"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := detectConflicts(tt.selected)
+			if len(tt.wantErr) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, errConflictingRegistrations)
+
+			for _, want := range tt.wantErr {
+				require.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestLookupExtension(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker":  {Module: "github.com/grafana/xk6-faker"},
+		"xk6-vanity": {Module: "go.example.com/xk6-vanity"},
+	}
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "xk6-faker", want: "xk6-faker"},
+		{query: "XK6-Faker", want: "xk6-faker"},
+		{query: "github.com/grafana/xk6-faker", want: "xk6-faker"},
+		{query: "GitHub.com/Grafana/xk6-faker", want: "xk6-faker"},
+		{query: "https://github.com/grafana/xk6-faker", want: "xk6-faker"},
+		{query: "https://github.com/grafana/xk6-faker.git", want: "xk6-faker"},
+		{query: "github.com/grafana/xk6-faker/", want: "xk6-faker"},
+		{query: "git@github.com:grafana/xk6-faker.git", want: "xk6-faker"},
+		{query: "grafana/xk6-faker", want: "xk6-faker"},
+		{query: "go.example.com/xk6-vanity", want: "xk6-vanity"},
+		{query: "example/xk6-vanity", want: ""},
+		{query: "github.com/grafana/xk6-faker-v2", want: ""},
+		{query: "github.com:grafana/xk6-faker", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			t.Parallel()
+
+			name, _ := lookupExtension(catalog, tt.query)
+			require.Equal(t, tt.want, name)
+		})
+	}
+}
+
+func TestLookupExtensionDuplicates(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-sql":        {Module: "github.com/grafana/xk6-sql"},
+		"xk6-sql-legacy": {Module: "GitHub.com/Grafana/xk6-sql"},
+		"a-sql-mirror":   {Module: "github.com/grafana/xk6-sql.git"},
+	}
+
+	for range 20 {
+		name, _ := lookupExtension(catalog, "github.com/grafana/xk6-sql")
+		require.Equal(t, "xk6-sql", name, "exact module match")
+
+		name, _ = lookupExtension(catalog, "https://github.com/grafana/xk6-sql")
+		require.Equal(t, "a-sql-mirror", name, "first name in sort order")
+	}
+}
+
+func TestRunBundleBeforeSearch(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+	ts.Env[configEnvVar] = "/explore.json"
+
+	require.NoError(t, fsext.WriteFile(ts.FS, "/explore.json",
+		[]byte(`{"bundles": {"data": ["xk6-faker", "xk6-sql"]}}`), 0o600))
+
+	_, err := exportSnapshot(ts.GlobalState, map[string]*extension{
+		"xk6-faker": {Module: "github.com/grafana/xk6-faker", Description: "Generate fake data"},
+		"xk6-sql":   {Module: "github.com/grafana/xk6-sql", Description: "Use SQL databases"},
+		"xk6-kafka": {Module: "github.com/grafana/xk6-kafka", Description: "Produce fake Kafka messages"},
+	}, "", "catalog.tar", time.Now())
+	require.NoError(t, err)
+
+	opts := options{
+		gs: ts.GlobalState, catalog: "bundle://catalog.tar", format: formatModule,
+		bundles: []string{"data"}, terms: []string{"fake"},
+	}
+
+	// The search terms narrow the bundle, the members they exclude are not reported as missing.
+	require.NoError(t, run(opts))
+	require.Equal(t, "github.com/grafana/xk6-faker\n", ts.Stdout.String())
+
+	for _, entry := range ts.LoggerHook.Drain() {
+		require.NotContains(t, entry.Message, "not found")
+	}
+}
+
+func TestRunBundleConflicts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		emit    emitTarget
+		wantErr error
+	}{
+		{name: "listing"},
+		{name: "emit", emit: emitGoGetTarget, wantErr: errConflictingRegistrations},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := cmdtests.NewGlobalTestState(t)
+			ts.Env[configEnvVar] = "/explore.json"
+
+			require.NoError(t, fsext.WriteFile(ts.FS, "/explore.json",
+				[]byte(`{"bundles": {"outputs": ["xk6-prometheus", "xk6-output-prometheus"]}}`), 0o600))
+
+			_, err := exportSnapshot(ts.GlobalState, map[string]*extension{
+				"xk6-prometheus":        {Module: "github.com/example/xk6-prometheus", Outputs: []string{"prometheus"}},
+				"xk6-output-prometheus": {Module: "github.com/grafana/xk6-output-prometheus", Outputs: []string{"prometheus"}},
+			}, "", "catalog.tar", time.Now())
+			require.NoError(t, err)
+
+			opts := options{
+				gs: ts.GlobalState, catalog: "bundle://catalog.tar", format: formatModule,
+				bundles: []string{"outputs"}, emit: tt.emit,
+			}
+
+			err = run(opts)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Empty(t, ts.Stdout.String())
+
+				return
+			}
+
+			// The conflict is only a warning when the bundle is not emitted for a build.
+			require.NoError(t, err)
+			require.Equal(t, "github.com/example/xk6-prometheus\ngithub.com/grafana/xk6-output-prometheus\n",
+				ts.Stdout.String())
+
+			entries := ts.LoggerHook.Drain()
+			require.Len(t, entries, 1)
+			require.Contains(t, entries[0].Message, `output "prometheus" is registered by`)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package explore
+
+import (
+	"fmt"
+	"runtime/debug"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+const (
+	capabilitiesHelpShort = "Describe the features of this explore extension"
+	capabilitiesHelpLong  = `Describe the features supported by the installed explore extension.
+
+The output lists the version of the extension, the output formats, the catalog
+sources, the flags, the filter flags, the fail-on conditions, the subcommands and the
+supported catalog schema versions. Orchestrating tools should use the JSON
+output (--json) to detect the features instead of parsing the help text.
+`
+	capabilitiesHelpExample = `
+# Describe the features of the installed extension:
+k6 x explore capabilities
+
+# Output as JSON, e.g. to check for a filter flag:
+k6 x explore capabilities --json | jq '.filters | index("owner")'
+`
+
+	extensionModule = "github.com/grafana/xk6-subcommand-explore"
+
+	// develVersion is the version of local builds, as reported by the go command.
+	develVersion = "(devel)"
+
+	sourceRegistry = "registry"
+	sourceSnapshot = "snapshot"
+)
+
+// filterFlags are the flags of the explore command which filter the listed extensions.
+//
+//nolint:gochecknoglobals
+var filterFlags = []string{
+	"tier", "type", "category", "owner", "repo-host", "subcommand-name", "version-constraint",
+	"min-stars", "license", "updated-since", "include-deprecated", "compat", "k6-version",
+	"cgo", "os", "arch", "filter", "match", "starred", "bundle",
+}
+
+// capabilities describes the features of the explore extension.
+type capabilities struct {
+	// Version is the module version of the extension, or (devel) for local builds.
+	Version string `json:"version"`
+	// Registry is the catalog URL of the registry used by default.
+	Registry string `json:"registry"`
+	// Formats are the values of the --format flag.
+	Formats []string `json:"formats"`
+	// Emit are the registered targets of the --emit flag.
+	Emit []string `json:"emit"`
+	// Sources are the catalog sources: the registry and snapshots (--catalog bundle://<file>).
+	Sources []string `json:"sources"`
+	// Flags are the names of the flags of the explore command, e.g. to check for --json-meta.
+	Flags []string `json:"flags"`
+	// Filters are the names of the filter flags.
+	Filters []string `json:"filters"`
+	// Kinds are the extension types of the catalog, the values of the --type flag.
+	Kinds []string `json:"kinds"`
+	// FailOn are the conditions of the --fail-on flag.
+	FailOn []string `json:"failOn"`
+	// Subcommands are the subcommands of explore.
+	Subcommands []string `json:"subcommands"`
+	// Schemas are the media types of the supported catalog schemas.
+	Schemas []string `json:"schemas"`
+}
+
+// newCapabilitiesCommand creates the "capabilities" subcommand of explore.
+func newCapabilitiesCommand(opts *options) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "capabilities",
+		Short:   capabilitiesHelpShort,
+		Long:    capabilitiesHelpLong,
+		Example: capabilitiesHelpExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			caps := describeCapabilities(*opts, cmd.Parent(), debug.ReadBuildInfo, capabilitiesCatalog(*opts))
+
+			if asJSON {
+				return outputJSON(opts.gs, caps)
+			}
+
+			return outputCapabilities(opts.gs, caps)
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+
+	return cmd
+}
+
+// capabilitiesCatalog loads the catalog the extension types are reported from, nil when
+// it cannot be loaded, e.g. offline: the capabilities are described all the same.
+func capabilitiesCatalog(opts options) map[string]*extension {
+	cfg, err := loadConfig(opts.gs)
+	if err == nil {
+		var catalog map[string]*extension
+
+		if catalog, err = loadCatalog(opts, cfg); err == nil {
+			return catalog
+		}
+	}
+
+	opts.gs.Logger.Debugf("Reporting the built-in extension types, the catalog cannot be loaded: %v", err)
+
+	return nil
+}
+
+// describeCapabilities collects the features of the explore command. The extension types
+// are those of the catalog, like in the --type completions, or the built-in ones without it.
+func describeCapabilities(
+	opts options,
+	explore *cobra.Command,
+	readBuildInfo func() (*debug.BuildInfo, bool),
+	catalog map[string]*extension,
+) *capabilities {
+	subcommands := make([]string, 0)
+
+	for _, sub := range explore.Commands() {
+		if sub.IsAvailableCommand() {
+			subcommands = append(subcommands, sub.Name())
+		}
+	}
+
+	schemas := make([]string, 0, len(catalogDecoders))
+	for mediaType := range catalogDecoders {
+		schemas = append(schemas, mediaType)
+	}
+
+	slices.Sort(schemas)
+
+	// The flags are taken from the command, so that new flags are never left out.
+	flags := make([]string, 0)
+
+	explore.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+		if !flag.Hidden {
+			flags = append(flags, flag.Name)
+		}
+	})
+
+	kinds := kindValues
+	if catalog != nil {
+		kinds = catalogKinds(catalog)
+	}
+
+	return &capabilities{
+		Version:     extensionVersion(readBuildInfo),
+		Registry:    catalogURL(opts),
+		Formats:     formatValues,
+		Emit:        emitterNames(),
+		Sources:     []string{sourceRegistry, sourceSnapshot},
+		Flags:       flags,
+		Filters:     filterFlags,
+		Kinds:       kinds,
+		FailOn:      []string{conditionDeprecated, conditionStale},
+		Subcommands: subcommands,
+		Schemas:     schemas,
+	}
+}
+
+// extensionVersion returns the module version of the extension from the build info.
+func extensionVersion(readBuildInfo func() (*debug.BuildInfo, bool)) string {
+	info, ok := readBuildInfo()
+	if !ok {
+		return develVersion
+	}
+
+	if info.Main.Path == extensionModule {
+		return info.Main.Version
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == extensionModule {
+			return dep.Version
+		}
+	}
+
+	return develVersion
+}
+
+func outputCapabilities(gs *state.GlobalState, caps *capabilities) error {
+	for _, line := range [][2]string{
+		{"Version", caps.Version},
+		{"Registry", caps.Registry},
+		{"Formats", strings.Join(caps.Formats, ", ")},
+		{"Emit", strings.Join(caps.Emit, ", ")},
+		{"Sources", strings.Join(caps.Sources, ", ")},
+		{"Flags", strings.Join(caps.Flags, ", ")},
+		{"Filters", strings.Join(caps.Filters, ", ")},
+		{"Kinds", strings.Join(caps.Kinds, ", ")},
+		{"Fail on", strings.Join(caps.FailOn, ", ")},
+		{"Subcommands", strings.Join(caps.Subcommands, ", ")},
+		{"Schemas", strings.Join(caps.Schemas, ", ")},
+	} {
+		_, _ = fmt.Fprintf(gs.Stdout, "%-12s %s\n", line[0]+":", line[1])
+	}
+
+	return nil
+}
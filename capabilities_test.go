@@ -0,0 +1,75 @@
+package explore
+
+import (
+	"encoding/json"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestDescribeCapabilities(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+	explore := newSubcommand(ts.GlobalState)
+
+	buildInfo := func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Deps: []*debug.Module{{Path: extensionModule, Version: "v1.2.3"}}}, true
+	}
+
+	caps := describeCapabilities(options{gs: ts.GlobalState}, explore, buildInfo, nil)
+
+	require.Equal(t, "v1.2.3", caps.Version)
+	require.Equal(t, []string{mediaTypeCatalogV1, mediaTypeCatalogV2}, caps.Schemas)
+	require.Contains(t, caps.Subcommands, "capabilities")
+	require.Contains(t, caps.Subcommands, "show")
+	require.Equal(t, kindValues, caps.Kinds, "the built-in types without catalog")
+
+	catalog := map[string]*extension{
+		"xk6-faker": {Module: "github.com/grafana/xk6-faker", Imports: []string{"k6/x/faker"}},
+		"xk6-vault": {Module: "github.com/grafana/xk6-vault", Capabilities: map[string][]string{"secret-source": {"vault"}}},
+	}
+
+	caps = describeCapabilities(options{gs: ts.GlobalState}, explore, buildInfo, catalog)
+	require.Equal(t, []string{"javascript", "secret-source"}, caps.Kinds)
+
+	for _, name := range []string{"fail-empty", "porcelain", "json-meta", "jq", "filter", "catalog", "no-network"} {
+		require.Contains(t, caps.Flags, name)
+	}
+
+	require.NotContains(t, caps.Flags, "render-golden", "hidden flags are not features")
+
+	for _, name := range caps.Filters {
+		require.NotNil(t, explore.Flags().Lookup(name), "filter flag %s", name)
+	}
+
+	_, err := exportSnapshot(ts.GlobalState, catalog, "", "catalog.tar", time.Now())
+	require.NoError(t, err)
+
+	sub, _, err := explore.Find([]string{"capabilities"})
+	require.NoError(t, err)
+	require.NoError(t, explore.PersistentFlags().Set("catalog", "bundle://catalog.tar"))
+	require.NoError(t, sub.Flags().Set("json", "true"))
+	require.NoError(t, sub.RunE(sub, nil))
+
+	var got capabilities
+
+	require.NoError(t, json.Unmarshal(ts.Stdout.Bytes(), &got))
+	require.Equal(t, formatValues, got.Formats)
+	require.Equal(t, []string{"javascript", "secret-source"}, got.Kinds)
+}
+
+func TestExtensionVersion(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "(devel)", extensionVersion(func() (*debug.BuildInfo, bool) { return nil, false }))
+	require.Equal(t, "v0.1.0", extensionVersion(func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Main: debug.Module{Path: extensionModule, Version: "v0.1.0"}}, true
+	}))
+	require.Equal(t, "(devel)", extensionVersion(func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Main: debug.Module{Path: "go.k6.io/k6/v2"}}, true
+	}))
+}
@@ -1,11 +1,16 @@
 package explore
 
 import (
+	"cmp"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -20,28 +25,180 @@ type extension struct {
 	Imports     []string    `json:"imports,omitempty"`
 	Outputs     []string    `json:"outputs,omitempty"`
 	Subcommands []string    `json:"subcommands,omitempty"`
+	Products    []string    `json:"products,omitempty"`
+	Categories  []string    `json:"categories,omitempty"`
+	Constraints string      `json:"constraints,omitempty"`
 	Repo        *repository `json:"repo,omitempty"`
+	Notes       string      `json:"notes,omitempty"`
+	// Deprecated is true when the registry marks the extension as deprecated.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// Deprecation is the deprecation timeline published by the registry, if any.
+	Deprecation *deprecation `json:"deprecation,omitempty"`
+	// Capabilities holds the extension points other than imports, outputs and subcommands,
+	// keyed by the extension type, e.g. secret-source. Each key is a kind of the --type flag.
+	Capabilities map[string][]string `json:"capabilities,omitempty"`
+	// Cgo is true when building the extension requires cgo, which prevents static cross-builds.
+	Cgo bool `json:"cgo,omitempty"`
+	// Platforms lists the supported platforms as os/arch pairs, e.g. linux/arm64.
+	// Extensions which don't declare their platforms are assumed to support all of them.
+	Platforms []string `json:"platforms,omitempty"`
+
+	// name is the catalog name of the extension, set by loadCatalog.
+	name string
+}
+
+// deprecation is the deprecation timeline of an extension, so that users can plan
+// their migration before the extension is removed from the registry.
+type deprecation struct {
+	// Since is the date of the deprecation, e.g. 2025-01-15.
+	Since string `json:"since,omitempty"`
+	// Removal is the planned date of the removal from the registry.
+	Removal string `json:"removal,omitempty"`
+	// Replacement is the module path of the extension to migrate to.
+	Replacement string `json:"replacement,omitempty"`
 }
 
 type repository struct {
 	URL string `json:"url"`
+	// Owner is the user or organization owning the repository.
+	Owner string `json:"owner,omitempty"`
+	// Archived is true when the repository is archived, i.e. no longer maintained.
+	Archived bool `json:"archived,omitempty"`
+	// Timestamp is the time of the last repository update, in Unix seconds.
+	Timestamp float64 `json:"timestamp,omitempty"`
+	// Stars is the number of stars of the repository.
+	Stars int `json:"stars,omitempty"`
+	// License is the SPDX identifier of the repository license, e.g. Apache-2.0.
+	License string `json:"license,omitempty"`
 }
 
 const httpRequestTimeout = 10 * time.Second
 
-var errFetchExtensionCatalog = errors.New("failed to fetch extension catalog")
+var (
+	errFetchExtensionCatalog = errors.New("failed to fetch extension catalog")
+	errFetchExtension        = errors.New("failed to fetch extension")
+	errExtensionNotFound     = errors.New("extension not found")
+	errNoExtensionEndpoint   = errors.New("per-extension endpoint not advertised by the registry")
+	errDuplicateModule       = errors.New("module listed under several names")
+)
+
+// invalidEntryFunc is called for each catalog entry which cannot be decoded.
+type invalidEntryFunc func(name string, err error)
+
+// progressFunc is called after each fetched page of a paginated catalog with the number
+// of pages fetched so far and the total number of pages, or 0 if the total is not known.
+type progressFunc func(fetched, total int)
+
+// catalogFetcher fetches and decodes the extension catalog from the registry.
+type catalogFetcher struct {
+	client *http.Client
+
+	// onInvalid is called for each invalid catalog entry, which is then skipped.
+	// When nil, a single invalid entry fails the whole fetch.
+	onInvalid invalidEntryFunc
+
+	// onProgress reports the progress of paginated fetches, if not nil.
+	onProgress progressFunc
+}
+
+func newCatalogFetcher(onInvalid invalidEntryFunc) *catalogFetcher {
+	return &catalogFetcher{
+		client:    &http.Client{Timeout: httpRequestTimeout},
+		onInvalid: onInvalid,
+	}
+}
+
+func (f *catalogFetcher) getExtensionCatalog(ctx context.Context, url string) (map[string]*extension, error) {
+	catalog, links, err := f.fetchCatalogPage(ctx, url)
+	if err != nil {
+		return nil, err
+	}
 
-func getExtensionCatalog(ctx context.Context, url string) (map[string]*extension, error) {
-	client := &http.Client{Timeout: httpRequestTimeout}
+	err = f.fetchRemainingPages(ctx, catalog, links)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the Latest field for each extension
+	for _, ext := range catalog {
+		ext.Latest = findLatest(ext.Versions, false)
+	}
+
+	return catalog, nil
+}
 
+// fetchCatalogPage fetches and decodes a single catalog document. The pagination
+// links of the response are returned keyed by relation type (next, last, ...).
+func (f *catalogFetcher) fetchCatalogPage(
+	ctx context.Context,
+	url string,
+) (map[string]*extension, map[string]string, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("User-Agent", "xk6-subcommand-explore")
+	req.Header.Set("Accept", catalogAccept)
+
+	resp, err := f.client.Do(req) //nolint:gosec // fetches the fixed k6 extension registry URL, not user-controlled input
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("%w: %w", errFetchExtensionCatalog, newStatusError(resp))
+	}
+
+	catalog, err := decoderFor(resp.Header.Get("Content-Type"))(resp.Body, f.onInvalid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return catalog, parseLinkHeader(resp.Request.URL, resp.Header.Values("Link")), nil
+}
+
+// extensionURL returns the per-extension registry endpoint which sits next to the catalog,
+// e.g. https://registry.k6.io/v2/catalog/xk6-faker.json for the xk6-faker extension.
+func extensionURL(catalogURL string, name string) (string, error) {
+	base, err := url.Parse(catalogURL)
+	if err != nil {
+		return "", err
+	}
+
+	dir := strings.TrimSuffix(base.EscapedPath(), ".json")
+
+	base.Path = strings.TrimSuffix(base.Path, ".json") + "/" + name + ".json"
+	base.RawPath = dir + "/" + url.PathEscape(name) + ".json"
+
+	return base.String(), nil
+}
+
+// getExtension fetches a single extension record from the per-extension registry endpoint,
+// avoiding the download of the whole catalog when only one extension is needed. Only the
+// registries serving the v2 schema advertise the endpoint, by answering with its media type;
+// other responses fail with errNoExtensionEndpoint, so the caller falls back to the catalog.
+// The record is validated like the catalog entries: an invalid record is reported to
+// onInvalid, if set, and fails with errInvalidEntry.
+func (f *catalogFetcher) getExtension(ctx context.Context, catalogURL string, name string) (*extension, error) {
+	extURL, err := extensionURL(catalogURL, name)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, extURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("User-Agent", "xk6-subcommand-explore")
+	req.Header.Set("Accept", mediaTypeCatalogV2)
 
-	resp, err := client.Do(req) //nolint:gosec // fetches the fixed k6 extension registry URL, not user-controlled input
+	resp, err := f.client.Do(req) //nolint:gosec // fetches from the k6 extension registry, not user-controlled input
 	if err != nil {
 		return nil, err
 	}
@@ -50,26 +207,138 @@ func getExtensionCatalog(ctx context.Context, url string) (map[string]*extension
 		_ = resp.Body.Close()
 	}()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", errExtensionNotFound, name)
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %s", errFetchExtensionCatalog, resp.Status)
+		return nil, fmt.Errorf("%w: %w", errFetchExtension, newStatusError(resp))
 	}
 
-	var catalog map[string]*extension
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != mediaTypeCatalogV2 {
+		return nil, fmt.Errorf("%w: %s", errNoExtensionEndpoint, extURL)
+	}
 
-	err = json.NewDecoder(resp.Body).Decode(&catalog)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	// Update the Latest field for each extension
-	for _, ext := range catalog {
-		ext.Latest = findLatest(ext.Versions)
+	record, err := decodeExtensionV2(body)
+	if err != nil {
+		if f.onInvalid != nil {
+			f.onInvalid(name, err)
+		}
+
+		return nil, fmt.Errorf("%w %s: %w", errInvalidEntry, name, err)
 	}
 
-	return catalog, nil
+	ext := record.toExtension()
+	ext.Latest = findLatest(ext.Versions, false)
+
+	return ext, nil
 }
 
-func findLatest(versions []string) string {
+// moduleDuplicate is a module listed under several names of the catalog.
+type moduleDuplicate struct {
+	module string
+	// kept is the name of the entry kept in the catalog.
+	kept string
+	// dropped are the names of the entries removed from the catalog.
+	dropped []string
+}
+
+// dedupeModules removes the entries listing a module already listed under another name,
+// which registries should not do, so an extension isn't shown twice with divergent metadata.
+// The kept entry is chosen deterministically: the one with the highest latest version, then
+// the most recently updated repository, then the first name in lexical order.
+func dedupeModules(catalog map[string]*extension) []moduleDuplicate {
+	names := make(map[string][]string)
+
+	for name, ext := range catalog {
+		names[ext.Module] = append(names[ext.Module], name)
+	}
+
+	var duplicates []moduleDuplicate
+
+	for module, listed := range names {
+		if len(listed) < 2 { //nolint:mnd
+			continue
+		}
+
+		slices.SortFunc(listed, func(a, b string) int {
+			extA, extB := catalog[a], catalog[b]
+
+			return cmp.Or(
+				compareVersions(extB.Latest, extA.Latest),
+				cmp.Compare(repoTimestamp(extB), repoTimestamp(extA)),
+				strings.Compare(a, b),
+			)
+		})
+
+		for _, name := range listed[1:] {
+			delete(catalog, name)
+		}
+
+		duplicates = append(duplicates, moduleDuplicate{module: module, kept: listed[0], dropped: listed[1:]})
+	}
+
+	slices.SortFunc(duplicates, func(a, b moduleDuplicate) int { return strings.Compare(a.module, b.module) })
+
+	return duplicates
+}
+
+// isDeprecated reports whether the extension is deprecated or its repository archived,
+// i.e. no longer maintained. A deprecation timeline implies the deprecation.
+func isDeprecated(ext *extension) bool {
+	return ext.Deprecated || ext.Deprecation != nil || (ext.Repo != nil && ext.Repo.Archived)
+}
+
+// repoStars returns the number of stars of the repository, 0 if unknown.
+func repoStars(ext *extension) int {
+	if ext.Repo == nil {
+		return 0
+	}
+
+	return ext.Repo.Stars
+}
+
+// repoTimestamp returns the time of the last repository update in Unix seconds, 0 if unknown.
+func repoTimestamp(ext *extension) float64 {
+	if ext.Repo == nil {
+		return 0
+	}
+
+	return ext.Repo.Timestamp
+}
+
+// compareVersions compares two versions in semver order. Empty and invalid versions
+// come before the valid ones.
+func compareVersions(a, b string) int {
+	verA, errA := semver.NewVersion(a)
+	verB, errB := semver.NewVersion(b)
+
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	case errB != nil:
+		return 1
+	default:
+		return verA.Compare(verB)
+	}
+}
+
+// findLatest returns the highest version. Prereleases are only considered when includePrerelease
+// is set or when there is no stable release, as users pin stable releases.
+func findLatest(versions []string, includePrerelease bool) string {
+	if !includePrerelease {
+		if stable := findLatestStable(versions); stable != "" {
+			return stable
+		}
+	}
+
 	if len(versions) == 0 {
 		return ""
 	}
@@ -3,8 +3,10 @@ package explore
 import (
 	"context"
 	"encoding/json"
+	"maps"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 	"time"
 
@@ -149,7 +151,7 @@ func TestGetExtensionCatalog(t *testing.T) {
 			defer server.Close()
 
 			ctx := context.Background()
-			catalog, err := getExtensionCatalog(ctx, server.URL)
+			catalog, err := newCatalogFetcher(nil).getExtensionCatalog(ctx, server.URL)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -175,7 +177,7 @@ func TestGetExtensionCatalogInvalidJSON(t *testing.T) {
 	defer server.Close()
 
 	ctx := context.Background()
-	catalog, err := getExtensionCatalog(ctx, server.URL)
+	catalog, err := newCatalogFetcher(nil).getExtensionCatalog(ctx, server.URL)
 
 	require.Error(t, err)
 	require.Nil(t, catalog)
@@ -195,7 +197,7 @@ func TestGetExtensionCatalogContextCancellation(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // Cancel immediately
 
-	catalog, err := getExtensionCatalog(ctx, server.URL)
+	catalog, err := newCatalogFetcher(nil).getExtensionCatalog(ctx, server.URL)
 
 	require.Error(t, err)
 	require.Nil(t, catalog)
@@ -205,7 +207,7 @@ func TestGetExtensionCatalogInvalidURL(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	catalog, err := getExtensionCatalog(ctx, "://invalid-url")
+	catalog, err := newCatalogFetcher(nil).getExtensionCatalog(ctx, "://invalid-url")
 
 	require.Error(t, err)
 	require.Nil(t, catalog)
@@ -215,7 +217,7 @@ func TestGetExtensionCatalogUnreachableServer(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	catalog, err := getExtensionCatalog(ctx, "http://localhost:0")
+	catalog, err := newCatalogFetcher(nil).getExtensionCatalog(ctx, "http://localhost:0")
 
 	require.Error(t, err)
 	require.Nil(t, catalog)
@@ -295,12 +297,42 @@ func TestFindLatest(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := findLatest(tt.versions)
+			got := findLatest(tt.versions, true)
 			require.Equal(t, tt.want, got)
 		})
 	}
 }
 
+func TestFindLatestStableByDefault(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "v0.4.4", findLatest([]string{"v0.4.4", "v0.5.0-beta.1", "v0.4.3"}, false))
+	require.Equal(t, "v0.5.0-beta.2",
+		findLatest([]string{"v0.5.0-beta.1", "v0.5.0-beta.2"}, false), "no stable release")
+	require.Empty(t, findLatest(nil, false))
+}
+
+func TestDedupeModules(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker":  {Module: "github.com/grafana/xk6-faker", Latest: "v0.4.3"},
+		"faker":      {Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4"},
+		"k6/x/faker": {Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4"},
+		"sql":        {Module: "github.com/grafana/xk6-sql", Latest: "v1.0.0"},
+		"xk6-sql":    {Module: "github.com/grafana/xk6-sql", Latest: "v1.0.0", Repo: &repository{Timestamp: 1}},
+		"xk6-kafka":  {Module: "github.com/mostafa/xk6-kafka", Latest: "v1.0.0"},
+	}
+
+	require.Equal(t, []moduleDuplicate{
+		{module: "github.com/grafana/xk6-faker", kept: "faker", dropped: []string{"k6/x/faker", "xk6-faker"}},
+		{module: "github.com/grafana/xk6-sql", kept: "xk6-sql", dropped: []string{"sql"}},
+	}, dedupeModules(catalog))
+	require.Equal(t, []string{"faker", "xk6-kafka", "xk6-sql"}, slices.Sorted(maps.Keys(catalog)))
+
+	require.Empty(t, dedupeModules(catalog))
+}
+
 func TestFilterExtensions(t *testing.T) {
 	t.Parallel()
 
@@ -542,7 +574,7 @@ func TestFilterExtensions(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			result := filterExtensions(tt.catalog, tt.kind, tt.tier)
+			result := filterExtensions(tt.catalog, &tt.kind, &tt.tier)
 
 			require.Len(t, result, tt.want)
 
@@ -552,3 +584,76 @@ func TestFilterExtensions(t *testing.T) {
 		})
 	}
 }
+
+func TestExtensionURL(t *testing.T) {
+	t.Parallel()
+
+	got, err := extensionURL("https://registry.k6.io/v2/catalog.json", "xk6-faker")
+	require.NoError(t, err)
+	require.Equal(t, "https://registry.k6.io/v2/catalog/xk6-faker.json", got)
+
+	got, err = extensionURL("https://registry.k6.io/v2/catalog.json", "a/b")
+	require.NoError(t, err)
+	require.Equal(t, "https://registry.k6.io/v2/catalog/a%2Fb.json", got)
+
+	_, err = extensionURL("://invalid-url", "xk6-faker")
+	require.Error(t, err)
+}
+
+func TestGetExtension(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/catalog/xk6-faker.json":
+			require.Equal(t, mediaTypeCatalogV2, r.Header.Get("Accept"))
+
+			w.Header().Set("Content-Type", mediaTypeCatalogV2+"; charset=utf-8")
+			_, _ = w.Write([]byte(`{
+				"name": "xk6-faker",
+				"module": "github.com/grafana/xk6-faker",
+				"versions": ["v0.4.3", "v0.4.4"],
+				"capabilities": {"imports": ["k6/x/faker"]}
+			}`))
+		case "/v2/catalog/xk6-v1.json":
+			w.Header().Set("Content-Type", mediaTypeCatalogV1)
+			_, _ = w.Write([]byte(`{"module": "github.com/grafana/xk6-v1"}`))
+		case "/v2/catalog/xk6-invalid.json":
+			w.Header().Set("Content-Type", mediaTypeCatalogV2)
+			_, _ = w.Write([]byte(`{"name": "xk6-invalid"}`))
+		case "/v2/catalog/xk6-broken.json":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	catalogURL := server.URL + "/v2/catalog.json"
+
+	ext, err := newCatalogFetcher(nil).getExtension(context.Background(), catalogURL, "xk6-faker")
+	require.NoError(t, err)
+	require.Equal(t, "github.com/grafana/xk6-faker", ext.Module)
+	require.Equal(t, "v0.4.4", ext.Latest)
+	require.Equal(t, []string{"k6/x/faker"}, ext.Imports)
+
+	_, err = newCatalogFetcher(nil).getExtension(context.Background(), catalogURL, "xk6-v1")
+	require.ErrorIs(t, err, errNoExtensionEndpoint)
+
+	_, err = newCatalogFetcher(nil).getExtension(context.Background(), catalogURL, "xk6-invalid")
+	require.ErrorIs(t, err, errInvalidEntry)
+
+	var skipped []string
+
+	_, err = newCatalogFetcher(func(name string, _ error) {
+		skipped = append(skipped, name)
+	}).getExtension(context.Background(), catalogURL, "xk6-invalid")
+	require.ErrorIs(t, err, errMissingModule)
+	require.Equal(t, []string{"xk6-invalid"}, skipped)
+
+	_, err = newCatalogFetcher(nil).getExtension(context.Background(), catalogURL, "xk6-missing")
+	require.ErrorIs(t, err, errExtensionNotFound)
+
+	_, err = newCatalogFetcher(nil).getExtension(context.Background(), catalogURL, "xk6-broken")
+	require.ErrorIs(t, err, errFetchExtension)
+}
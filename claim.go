@@ -0,0 +1,155 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+var errNameTaken = errors.New("name already taken")
+
+const (
+	claimHelpShort = "Check whether an extension name is available"
+	claimHelpLong  = `Check whether a name is still available in the extension catalog.
+
+The name is checked in the three namespaces of k6 extensions: the subcommands
+(k6 x <name>), the output names (k6 run --out <name>) and the JavaScript
+imports (k6/x/<name>). Extension authors can check a proposed name before
+development starts, to avoid collisions with the existing extensions.
+
+The name may be given with the k6/x/ prefix. The command fails if the name is
+taken in any namespace, so it can be used in scripts as well.
+`
+	claimHelpExample = `
+# Check whether a name is available:
+k6 x explore claim faker
+
+# Check a JavaScript import path:
+k6 x explore claim k6/x/faker
+
+# Output as JSON:
+k6 x explore claim faker --json
+`
+
+	claimHeader = "NAMESPACE\tNAME\tSTATUS\n"
+
+	namespaceSubcommand = "subcommand"
+	namespaceOutput     = "output"
+	namespaceImport     = "import"
+
+	importPrefix = "k6/x/"
+)
+
+// claim is the availability of a name in one of the namespaces of k6 extensions.
+type claim struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// TakenBy lists the module paths of the extensions using the name.
+	TakenBy []string `json:"takenBy,omitempty"`
+}
+
+// newClaimCommand creates the "claim" subcommand of explore.
+func newClaimCommand(opts *options) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "claim <name>",
+		Short:   claimHelpShort,
+		Long:    claimHelpLong,
+		Example: claimHelpExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cfg, err := loadConfig(opts.gs)
+			if err != nil {
+				return err
+			}
+
+			catalog, err := loadCatalog(*opts, cfg)
+			if err != nil {
+				return err
+			}
+
+			claims := checkClaims(catalog, args[0])
+
+			if asJSON {
+				err = outputJSON(opts.gs, claims)
+			} else {
+				err = outputClaims(opts.gs, claims)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for _, c := range claims {
+				if len(c.TakenBy) > 0 {
+					return fmt.Errorf("%w: %s", errNameTaken, args[0])
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+
+	return cmd
+}
+
+// checkClaims returns the availability of the name as subcommand, output and import.
+func checkClaims(catalog map[string]*extension, name string) []*claim {
+	name = strings.TrimPrefix(name, importPrefix)
+
+	claims := []*claim{
+		{Namespace: namespaceSubcommand, Name: name},
+		{Namespace: namespaceOutput, Name: name},
+		{Namespace: namespaceImport, Name: importPrefix + name},
+	}
+
+	for _, ext := range catalog {
+		for _, c := range claims {
+			var names []string
+
+			switch c.Namespace {
+			case namespaceSubcommand:
+				names = ext.Subcommands
+			case namespaceOutput:
+				names = ext.Outputs
+			case namespaceImport:
+				names = ext.Imports
+			}
+
+			if slices.Contains(names, c.Name) {
+				c.TakenBy = append(c.TakenBy, ext.Module)
+			}
+		}
+	}
+
+	for _, c := range claims {
+		slices.Sort(c.TakenBy)
+	}
+
+	return claims
+}
+
+func outputClaims(gs *state.GlobalState, claims []*claim) error {
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	_, _ = w.Write([]byte(claimHeader))
+
+	for _, c := range claims {
+		status := "available"
+		if len(c.TakenBy) > 0 {
+			status = "taken by " + strings.Join(c.TakenBy, ", ")
+		}
+
+		_, _ = w.Write([]byte(c.Namespace + "\t" + c.Name + "\t" + status + "\n"))
+	}
+
+	return w.Flush()
+}
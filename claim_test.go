@@ -0,0 +1,72 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestCheckClaims(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-dashboard": {
+			Module:      "github.com/grafana/xk6-dashboard",
+			Outputs:     []string{"dashboard"},
+			Subcommands: []string{"dashboard"},
+		},
+		"xk6-faker": {Module: "github.com/grafana/xk6-faker", Imports: []string{"k6/x/faker"}},
+		"xk6-fake":  {Module: "github.com/example/xk6-fake", Imports: []string{"k6/x/faker"}},
+	}
+
+	tests := []struct {
+		name string
+		want []*claim
+	}{
+		{
+			name: "dashboard",
+			want: []*claim{
+				{Namespace: namespaceSubcommand, Name: "dashboard", TakenBy: []string{"github.com/grafana/xk6-dashboard"}},
+				{Namespace: namespaceOutput, Name: "dashboard", TakenBy: []string{"github.com/grafana/xk6-dashboard"}},
+				{Namespace: namespaceImport, Name: "k6/x/dashboard"},
+			},
+		},
+		{
+			name: "k6/x/faker",
+			want: []*claim{
+				{Namespace: namespaceSubcommand, Name: "faker"},
+				{Namespace: namespaceOutput, Name: "faker"},
+				{
+					Namespace: namespaceImport, Name: "k6/x/faker",
+					TakenBy: []string{"github.com/example/xk6-fake", "github.com/grafana/xk6-faker"},
+				},
+			},
+		},
+		{
+			name: "kafka",
+			want: []*claim{
+				{Namespace: namespaceSubcommand, Name: "kafka"},
+				{Namespace: namespaceOutput, Name: "kafka"},
+				{Namespace: namespaceImport, Name: "k6/x/kafka"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, checkClaims(catalog, tt.name))
+		})
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputClaims(ts.GlobalState, checkClaims(catalog, "dashboard")))
+	require.Equal(t, `NAMESPACE   NAME            STATUS
+subcommand  dashboard       taken by github.com/grafana/xk6-dashboard
+output      dashboard       taken by github.com/grafana/xk6-dashboard
+import      k6/x/dashboard  available
+`, ts.Stdout.String())
+}
@@ -3,35 +3,71 @@ package explore
 
 import (
 	"errors"
+	"fmt"
 	"runtime/debug"
-	"sort"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.k6.io/k6/v2/cmd/state"
 )
 
-var errMutuallyExclusiveFlags = errors.New("flags --brief, --detailed and --json are mutually exclusive")
+// progressMinPages is the number of catalog pages from which the fetch progress is reported.
+const progressMinPages = 10
+
+var (
+	errMutuallyExclusiveFlags = errors.New("flags --brief, --detailed, --plain, --porcelain, --json, --format, --emit, --output-dir and --count are mutually exclusive")
+	errInvalidMaxVersions     = errors.New("invalid --max-versions value: expected a non-negative number")
+	errConflictingDates       = errors.New("flags --relative-dates and --absolute-dates are mutually exclusive")
+	errConflictingColumns     = errors.New("flags --brief and --columns are mutually exclusive")
+	errInvalidPage            = errors.New("invalid --limit or --offset value: expected a non-negative number")
+)
 
 const (
 	helpShort = "Explore k6 extensions for Automatic Resolution"
 	helpLong  = `List available k6 extensions from the official extension registry.
 
-Filter extensions by type (javascript, output, subcommand) or tier (official, community).
-Supports table output (default) and JSON format for machine-readable output.
+Filter extensions by type (javascript, output, subcommand, ...) or tier (official, community, ...).
+Search terms given as arguments list the extensions whose name, module path, description,
+imports, outputs or subcommands contain any of the terms. Search terms and --match
+ignore case unless --case-sensitive is given.
+Supports table output (default), JSON format for machine-readable output and
+catalog statistics in Prometheus exposition format (--format prom).
 
 When using the --json flag, the output is an array of extension objects.
-Each extension object contains the following properties:
+Each extension object contains the following properties, always in this order,
+the optional ones being omitted when empty:
 
 - module (string) The Go module path of the extension
-- tier (string) Extension tier: official or community
+- tier (string) Extension tier, normalized to lowercase (e.g., official, community)
+- type (string) Main extension type, the first of kinds, as in the TYPE column of the table
 - description (string) Brief description of the extension's functionality
 - latest (string) Latest version tag (e.g., v0.1.0)
+- latestStable (string) Newest version which is not a prerelease, if any
+- latestPrerelease (string) Newest prerelease if it is newer than latestStable
 - versions (array of strings) All available version tags
+- kinds (array of strings) Extension types derived from the following properties:
+  javascript, output, subcommand, then the types of the other capabilities; the set is open
 - imports (array of strings) JavaScript module import paths (for JavaScript extensions)
 - outputs (array of strings) Output type names (for output extensions)
 - subcommands (array of strings) Subcommand names (for subcommand extensions)
-- repo (object) Repository information including URL
+- capabilities (object) Other extension points of the extension, keyed by type (e.g., secret-source)
+- categories (array of strings) Categories of the extension (e.g., data, messaging)
+- products (array of strings) k6 products supporting the extension (e.g., oss, cloud)
+- constraints (string) k6 version constraints of the extension, if any
+- repo (object) Repository information including URL, stars and license
+- notes (string) Annotation from the notes file (--notes), if any
+- platforms (array of strings) Supported platforms as os/arch pairs, if not all
+- cgo (boolean) True when building the extension requires cgo
+- deprecated (boolean) True when the extension is deprecated or its repository archived,
+  such extensions are only listed with --include-deprecated
+- deprecation (object) Deprecation timeline published by the registry: since, removal, replacement
+
+With --json-meta, the array is wrapped in an object with the schemaVersion of the format,
+the catalogURL, fetchedAt, cacheHit, totalCount and filteredCount properties and the
+extensions. The lines of the ndjson format start with the schemaVersion property.
+--json-schema prints the JSON Schema of these outputs.
 
 `
 	helpExample = `
@@ -41,17 +77,144 @@ k6 x explore
 # Show only module and description columns (brief output):
 k6 x explore --brief
 
+# Print only the module paths, one per line:
+k6 x explore --tier official -q
+
+# Print only the number of community extensions:
+k6 x explore --tier community --count
+
+# Show all the columns without truncation:
+k6 x explore -o wide
+
+# Export the extensions for a spreadsheet, with semicolons as delimiters:
+k6 x explore -o csv --csv-delimiter ';' > extensions.csv
+
+# Stream one JSON object per extension and line, e.g. into DuckDB:
+k6 x explore -o ndjson > extensions.ndjson
+
+# Print module@latest of the official JavaScript extensions, e.g. for go get:
+k6 x explore --tier official --type javascript -o module-version
+
+# Parse the extensions in a script, in a format which is stable across releases:
+k6 x explore --porcelain | tail -n +2 | cut -f1,2
+
+# Print a custom line per extension with a Go template:
+k6 x explore -o 'go-template={{.Module}}@{{.Latest}} {{join .Imports ","}}'
+
+# Publish the official extensions as a web page from CI:
+k6 x explore --tier official -o html > extensions.html
+
+# Print the module and stars of each extension for awk or cut:
+k6 x explore -o tsv --columns module,stars | tail -n +2 | cut -f2
+
+# Choose the columns of the table and their order:
+k6 x explore --columns module,latest,stars,description
+
 # Show full descriptions without truncation:
 k6 x explore --no-trunc
 
-# Show detailed information with repository URLs:**
+# Show detailed information with repository URLs:
 k6 x explore --detailed
 
+# Show screen reader friendly output:
+k6 x explore --plain
+
 # Output as JSON (for CI/CD integration):
 k6 x explore --json
 
+# Export catalog statistics for Prometheus (e.g. from a cron job):
+k6 x explore --format prom
+
+# Publish a nightly report of the catalog:
+k6 x explore --output-dir report
+
+# Report only new or updated extensions from a cron job:
+k6 x explore --silent-success
+
+# Print the go get commands of the official JavaScript extensions:
+k6 x explore --tier official --type javascript --emit go-get
+
+# Show the details of a single extension:
+k6 x explore show xk6-faker
+
+# Search for extensions by name, description or import path:
+k6 x explore faker kafka
+
+# Fail a CI pipeline on archived or unmaintained official extensions:
+k6 x explore --tier official --fail-on deprecated,stale>12mo
+
+# Fail a CI pipeline if a required extension vanished from the registry:
+k6 x explore --match '^github.com/grafana/xk6-sql$' --fail-empty
+
+# Filter by a regular expression on the module path or description:
+k6 x explore --match '^github.com/grafana/.*sql'
+
 # Filter by tier or type:
 k6 x explore --tier official --type javascript
+
+# List the extensions of several tiers:
+k6 x explore --tier official,partner
+
+# List the JavaScript and output extensions:
+k6 x explore --type javascript,output
+
+# List the third-party extensions only:
+k6 x explore --owner '!grafana'
+
+# List the extensions hosted on GitHub only:
+k6 x explore --repo-host github.com
+
+# Find the extension providing the "k6 x dashboard" subcommand:
+k6 x explore --subcommand-name dashboard
+
+# Exclude the extensions with less than 20 repository stars:
+k6 x explore --min-stars 20
+
+# List the extensions usable with the running k6 binary:
+k6 x explore --compat
+
+# List the extensions usable with a pinned k6 release:
+k6 x explore --k6-version v1.2.0
+
+# List the extensions having a v1 release, with the latest v1 version:
+k6 x explore --version-constraint "^v1.0.0"
+
+# List the extensions which can be built without cgo, e.g. for static cross-builds:
+k6 x explore --cgo=false
+
+# List the extensions supporting a Raspberry Pi:
+k6 x explore --os linux --arch arm64
+
+# List the extensions updated in the last 90 days:
+k6 x explore --updated-since 90d
+
+# List the extensions under an acceptable license:
+k6 x explore --license Apache-2.0,MIT
+
+# List the official JavaScript extensions with more than 100 stars:
+k6 x explore --filter 'tier == "official" && size(imports) > 0 && stars > 100'
+
+# Print the module paths without an external jq binary:
+k6 x explore --jq '.[].module'
+
+# List the most starred extensions first:
+k6 x explore --sort -stars
+
+# List the extensions in a section per tier:
+k6 x explore --group-by tier
+
+# List the 10 most starred extensions, then the next 10:
+k6 x explore --sort -stars --limit 10
+k6 x explore --sort -stars --limit 10 --offset 10
+
+# Filter by category:
+k6 x explore --category messaging --category browser
+
+# Check whether a name is still available for a new extension:
+k6 x explore claim my-tool
+
+# List the extensions of a bundle defined in the configuration file:
+k6 x explore --bundle observability
 `
 )
 
@@ -60,19 +223,57 @@ func newSubcommand(gs *state.GlobalState) *cobra.Command {
 	opts := options{gs: gs}
 
 	cmd := &cobra.Command{
-		Use:     "explore",
+		Use:     "explore [term...]",
 		Short:   helpShort,
 		Long:    helpLong,
 		Example: helpExample,
-		RunE: func(_ *cobra.Command, _ []string) error {
+		Args:    cobra.ArbitraryArgs,
+		RunE: func(_ *cobra.Command, args []string) error {
+			opts.terms = args
+
+			if opts.renderGolden != "" {
+				return renderGolden(opts.gs, opts.renderGolden)
+			}
+
+			if opts.jsonSchema {
+				return outputJSONSchema(opts.gs)
+			}
+
 			return run(opts)
 		},
 
 		PreRunE: func(_ *cobra.Command, _ []string) error {
-			if (opts.brief && opts.detailed) || (opts.brief && opts.json) || (opts.detailed && opts.json) {
+			modes := 0
+
+			for _, set := range []bool{
+				opts.brief, opts.detailed, opts.plain, opts.json || opts.jq.isSet() || opts.jsonMeta,
+				opts.format != "" && opts.format != formatTable,
+				opts.emit != "",
+				opts.outputDir != "",
+				opts.count,
+				opts.porcelain != "",
+			} {
+				if set {
+					modes++
+				}
+			}
+
+			if modes > 1 {
 				return errMutuallyExclusiveFlags
 			}
 
+			if opts.maxVersions < 0 {
+				return fmt.Errorf("%w: %d", errInvalidMaxVersions, opts.maxVersions)
+			}
+
+			if opts.brief && len(opts.columns) > 0 {
+				return errConflictingColumns
+			}
+
+			if opts.limit < 0 || opts.offset < 0 {
+				return fmt.Errorf("%w: %d, %d", errInvalidPage, opts.limit, opts.offset)
+			}
+
 			return nil
 		},
 	}
@@ -80,39 +281,523 @@ func newSubcommand(gs *state.GlobalState) *cobra.Command {
 	flags := cmd.Flags()
 
 	flags.BoolVar(&opts.json, "json", false, "output in JSON format")
+	flags.BoolVar(&opts.count, "count", false, "print only the number of listed extensions")
 	flags.BoolVar(&opts.brief, "brief", false, "show only module and description columns")
-	flags.BoolVar(&opts.detailed, "detailed", false, "output as a list with detailed information")
+	flags.BoolVarP(&opts.detailed, "detailed", "l", false, "output as a list with detailed information")
+	flags.Var(&opts.porcelain, "porcelain",
+		"output in a stable tab-separated format for scripts, which keeps its layout across releases (v1)")
+	flags.Lookup("porcelain").NoOptDefVal = string(porcelainV1)
+	flags.BoolVar(&opts.plain, "plain", false,
+		"output label: value lines without color, abbreviations or alignment (e.g. for screen readers)")
+	flags.Var(&opts.columns, "columns",
+		"columns of the table output, in order ("+strings.Join(columnNames(), ",")+"), e.g. module,latest,stars,description")
 	flags.BoolVar(&opts.notrunc, "no-trunc", false, "do not truncate descriptions in table output")
-	flags.Var(&opts.tier, "tier", "filter by tier ("+strings.Join(tierValues, ",")+")")
-	flags.Var(&opts.kind, "type", "filter by type ("+strings.Join(kindValues, ",")+")")
+	flags.Var(&opts.ellipsis, "ellipsis",
+		"truncation indicator of descriptions in table output ("+strings.Join(ellipsisValues, ",")+"), default ascii")
+	flags.BoolVar(&opts.longValues, "long-values", false,
+		"print the type and tier in full words instead of abbreviations in table output")
+	flags.Var(&opts.tiers, "tier", "filter by tier (e.g. "+strings.Join(tierValues, ",")+"), repeatable")
+	flags.Var(&opts.categories, "category", "filter by category (e.g. messaging), repeatable")
+	flags.Var(&opts.owners, "owner", "filter by repository owner (e.g. grafana), prefix with ! to exclude, repeatable")
+	flags.Var(&opts.repoHosts, "repo-host", "filter by the code host of the module path (e.g. github.com), repeatable")
+	flags.Var(&opts.subcommands, "subcommand-name", "list the extension providing the k6 x <name> subcommand, repeatable")
+	flags.Var(&opts.licenses, "license", "filter by repository license (e.g. Apache-2.0,MIT), repeatable")
+	flags.Var(&opts.updatedSince, "updated-since",
+		"only list the extensions updated since a date (e.g. 2024-01-01) or for an age (e.g. 90d, 6mo)")
+	flags.Var(&opts.versions, "version-constraint",
+		"only list the extensions with a version satisfying the constraint (e.g. >=v0.5.0), shown as the latest")
+	flags.Var(&opts.minStars, "min-stars", "only list the extensions whose repository has at least the given number of stars")
+	flags.Var(&opts.kinds, "type", "filter by type ("+strings.Join(kindValues, ",")+"), repeatable")
+	flags.Var(&opts.match, "match", "filter by a regular expression matching the module path or the description")
+	flags.Var(&opts.expr, "filter",
+		`filter by a CEL expression on the extension fields (e.g. 'tier == "official" && stars > 100')`)
+	flags.BoolVar(&opts.caseSensitive, "case-sensitive", false, "match search terms and --match case-sensitively")
+	flags.BoolVar(&opts.jsonMeta, "json-meta", false,
+		"wrap the JSON output in an object telling the catalog URL, fetch time, cache hit and counts, implies --json")
+	flags.BoolVar(&opts.jsonSchema, "json-schema", false,
+		"print the JSON Schema of the JSON output (e.g. to generate typed clients) instead of listing extensions")
+	flags.Var(&opts.jq, "jq", "apply a jq query to the JSON output (e.g. '.[].module'), implies --json")
+	flags.IntVar(&opts.maxVersions, "max-versions", 0, "list only the newest N versions in JSON output, 0 means all")
+	flags.Var(&opts.sort, "sort",
+		"sort by keys ("+strings.Join(sortKeyNames(), ",")+"), descending when prefixed with - (e.g. -stars,module)")
+	flags.Var(&opts.groupBy, "group-by",
+		"split the table output into sections by "+strings.Join(groupValues, ", ")+" with a heading each")
+	flags.IntVar(&opts.limit, "limit", 0, "list at most N extensions after sorting (e.g. --sort -stars --limit 10), 0 means all")
+	flags.IntVar(&opts.offset, "offset", 0, "skip the first N extensions after sorting, for paging with --limit")
+	flags.VarP(&opts.format, "format", "o",
+		"output format ("+strings.Join(formatValues, ",")+"), wide adds the imports, outputs, subcommands, stars, "+
+			"license and constraints columns without truncation, csv and tsv have the same columns for spreadsheets and awk, "+
+			"html is a standalone page with a sortable table, go-template=TEMPLATE renders a Go template per extension "+
+			"(e.g. 'go-template={{.Module}}@{{.Latest}}'), name, module and module-version print the catalog name, "+
+			"module path or module@latest per line")
+	flags.Var(&opts.delimiter, "csv-delimiter", "field delimiter of the csv format (e.g. ';'), default ','")
+	flags.Var(&opts.emit, "emit", "emit build inputs for the listed extensions ("+strings.Join(emitterNames(), ",")+")")
+	flags.BoolVar(&opts.failEmpty, "fail-empty", false,
+		"exit with an error if no extension is listed (e.g. a required extension vanished from the registry)")
+	flags.Var(&opts.failOn, "fail-on",
+		"exit with an error if any listed extension matches a condition (deprecated, stale>DURATION e.g. stale>12mo)")
+	flags.Var(&opts.cgo, "cgo", "only list the extensions requiring cgo, or with --cgo=false those not requiring cgo")
+	flags.Lookup("cgo").NoOptDefVal = "true"
+	flags.StringVar(&opts.platform.os, "os", "", "only list the extensions supporting the operating system (e.g. linux)")
+	flags.StringVar(&opts.platform.arch, "arch", "", "only list the extensions supporting the architecture (e.g. arm64)")
+	flags.BoolVar(&opts.compat, "compat", false,
+		"only list the extensions whose k6 version constraints are satisfied by the running k6")
+	flags.BoolVar(&opts.annotate, "annotate-k6-compat", false,
+		"add a COMPAT column telling whether the k6 version constraints match the running k6 (or --k6-version)")
+	flags.Var(&opts.k6Version, "k6-version",
+		"only list the extensions whose k6 version constraints are satisfied by the given k6 release (e.g. v1.2.0)")
+	flags.BoolVar((*bool)(&opts.deprecated), "include-deprecated", false,
+		"also list the deprecated extensions and those with an archived repository")
+	flags.BoolVar(&opts.starred, "starred", false, "only list the starred extensions (see the star subcommand)")
+	flags.StringSliceVar(&opts.bundles, "bundle", nil, "only list the extensions of the given bundle(s)")
+	flags.StringVar(&opts.outputDir, "output-dir", "",
+		"write a report (JSON snapshot, Markdown table, HTML page, statistics) into the directory")
+	flags.BoolVar(&opts.silentSuccess, "silent-success", false,
+		"print nothing if the results did not change since the previous run (for scheduled jobs)")
+	flags.StringVar(&opts.renderGolden, "render-golden", "",
+		"write the outputs of the fixture catalog into the directory, as reference for formatter changes")
+	_ = flags.MarkHidden("render-golden")
+
+	// Catalog related flags apply to the subcommands as well.
+	persistent := cmd.PersistentFlags()
+
+	persistent.BoolVar(&opts.strict, "strict", false, "fail on invalid catalog entries instead of skipping them")
+	persistent.StringVar(&opts.catalog, "catalog", "", "read the catalog from a snapshot (bundle://<file>) instead of the registry")
+	persistent.BoolVar(&opts.prerelease, "include-prerelease", false,
+		"consider prerelease versions for the latest version, by default the newest stable release")
+	persistent.StringVar(&opts.notes, "notes", "", "merge annotations from a notes file (JSON object of module to note)")
+	persistent.StringVar(&opts.reuseFetch, "reuse-fetch", "",
+		"fetch the catalog once and reuse it in the invocations with the same token (e.g. the CI job ID)")
+	persistent.BoolVar(&opts.utc, "utc", false, "print dates in UTC (RFC 3339) instead of the local time zone")
+	persistent.BoolVar(&opts.relativeDates, "relative-dates", false,
+		"print dates relative to now (e.g. 3 weeks ago), the default on a terminal")
+	persistent.BoolVar(&opts.absoluteDates, "absolute-dates", false,
+		"print exact dates, the default when not on a terminal (e.g. in CI logs)")
+	persistent.Var(&opts.numbers, "number-format",
+		"format of counts such as stars ("+strings.Join(numberValues, ",")+"), e.g. 12345, 12,345 or 12.3k, default plain")
+	persistent.BoolVar(&opts.noNetwork, "no-network", false,
+		"fail instead of accessing the network, e.g. in sandboxes (use with --catalog or --reuse-fetch)")
+	persistent.StringVar(&opts.traceFile, "trace-file", "", "write the HTTP interactions to a HAR file (e.g. for bug reports)")
+
+	registerCompletions(cmd, &opts)
+
+	cmd.AddCommand(newBundlesCommand(gs))
+	cmd.AddCommand(newShowCommand(&opts))
+	cmd.AddCommand(newDepsCommand(&opts))
+	cmd.AddCommand(newVersionsCommand(&opts))
+	cmd.AddCommand(newLatestCommand(&opts))
+	cmd.AddCommand(newStarCommand(&opts))
+	cmd.AddCommand(newExportCommand(&opts))
+	cmd.AddCommand(newClaimCommand(&opts))
+	cmd.AddCommand(newDuplicatesCommand(&opts))
+	cmd.AddCommand(newDeprecationsCommand(&opts))
+	cmd.AddCommand(newExplainCommand(&opts))
+	cmd.AddCommand(newCapabilitiesCommand(&opts))
+
+	addTrace(cmd, &opts)
+	addRemediation(cmd)
 
 	return cmd
 }
 
 func run(opts options) error {
-	url := catalogURLForVersion(detectK6Major(opts.gs.Env, debug.ReadBuildInfo))
+	opts.summary = newRunSummary(opts.gs, opts.jsonMeta)
 
-	catalog, err := getExtensionCatalog(opts.gs.Ctx, url)
+	listed := 0
+
+	defer func() { opts.summary.write(opts.gs, listed) }()
+
+	cfg, err := loadConfig(opts.gs)
 	if err != nil {
 		return err
 	}
 
-	extensions := filterExtensions(catalog, opts.kind, opts.tier)
+	catalog, err := loadCatalog(opts, cfg)
+	if err != nil {
+		return err
+	}
+
+	fetchedAt := time.Now().In(timeZone(opts.utc))
+
+	opts.summary.loaded(len(filterExtensions(catalog)))
+
+	if cfg.LongValues {
+		opts.longValues = true
+	}
+
+	if err := opts.tiers.validate(catalogTiers(catalog)); err != nil {
+		return err
+	}
+
+	if err := opts.kinds.validate(catalogKinds(catalog)); err != nil {
+		return err
+	}
+
+	// The bundle members are looked up in the whole catalog, before the search terms and
+	// the starred shortlist narrow it, so that they are not reported as missing.
+	if len(opts.bundles) > 0 {
+		catalog, err = expandBundles(opts.gs, catalog, cfg.Bundles, opts.bundles)
+		if err != nil {
+			return err
+		}
+
+		// The conflicts only prevent building the bundle, listing it is fine.
+		if err := detectConflicts(catalog); err != nil {
+			if opts.emit != "" {
+				return err
+			}
+
+			opts.gs.Logger.Warnf("%v", err)
+		}
+	}
 
-	sortExtensions(extensions)
+	if len(opts.terms) > 0 {
+		catalog = searchCatalog(catalog, opts.terms, opts.caseSensitive)
+	}
+
+	if opts.starred {
+		starred, err := loadStarred(opts.gs)
+		if err != nil {
+			return err
+		}
 
-	if opts.json {
-		return outputJSON(opts.gs, extensions)
+		catalog = starredCatalog(catalog, starred)
 	}
 
+	if !opts.caseSensitive {
+		opts.match.ignoreCase()
+	}
+
+	// The deprecated policy check needs to see the deprecated extensions.
+	if opts.failOn.has(conditionDeprecated) {
+		opts.deprecated = true
+	}
+
+	filters := []extensionFilter{
+		&opts.kinds, &opts.tiers, &opts.match, &opts.categories,
+		&opts.owners, &opts.repoHosts, &opts.subcommands, &opts.minStars,
+		&opts.licenses, &opts.updatedSince, &opts.deprecated, &opts.versions, &opts.cgo,
+		&opts.platform, &opts.expr,
+	}
+
+	if opts.compat || opts.k6Version != "" {
+		compat, err := newCompatFilter(targetK6Version(opts))
+		if err != nil {
+			return err
+		}
+
+		filters = append(filters, compat)
+	}
+
+	extensions := opts.versions.pin(filterExtensions(catalog, filters...))
+
+	sortExtensions(extensions, opts.sort)
+
+	extensions = pageExtensions(extensions, opts.offset, opts.limit)
+	listed = len(extensions)
+
+	if opts.silentSuccess {
+		unchanged, err := unchangedSinceLastRun(opts.gs, extensions)
+		if err != nil {
+			return err
+		}
+
+		if unchanged {
+			return checkResults(opts, extensions, fetchedAt)
+		}
+	}
+
+	if err := output(opts, extensions, fetchedAt); err != nil {
+		return err
+	}
+
+	return checkResults(opts, extensions, fetchedAt)
+}
+
+func output(opts options, extensions []*extension, fetchedAt time.Time) error {
+	if opts.count {
+		_, _ = fmt.Fprintln(opts.gs.Stdout, len(extensions))
+
+		return nil
+	}
+
+	if opts.outputDir != "" {
+		return writeReport(opts.gs, opts.outputDir, extensions, fetchedAt)
+	}
+
+	if opts.porcelain != "" {
+		return outputPorcelain(opts.gs, extensions)
+	}
+
+	if opts.emit != "" {
+		return emit(opts.gs, opts.emit, extensions)
+	}
+
+	if opts.json || opts.jsonMeta || opts.jq.isSet() || opts.format == formatJSON {
+		list := trimVersions(toJSONList(extensions), opts.maxVersions)
+
+		var result any = list
+
+		if opts.jsonMeta {
+			result = newJSONMeta(opts.summary, fetchedAt, list)
+		}
+
+		if opts.jq.isSet() {
+			return outputJQ(opts.gs, &opts.jq, result)
+		}
+
+		return outputJSON(opts.gs, result)
+	}
+
+	if opts.format == formatNDJSON {
+		return outputNDJSON(opts.gs, toNDJSONLines(trimVersions(toJSONList(extensions), opts.maxVersions)))
+	}
+
+	switch opts.format {
+	case formatName:
+		return outputNames(opts.gs, extensions)
+	case formatModule:
+		return outputModules(opts.gs, extensions)
+	case formatModuleVersion:
+		return outputModuleVersions(opts.gs, extensions)
+	}
+
+	if spec, ok := opts.format.template(); ok {
+		return outputTemplate(opts.gs, spec, toJSONList(extensions))
+	}
+
+	if opts.format == formatProm {
+		return outputProm(opts.gs, extensions, fetchedAt)
+	}
+
+	if opts.plain {
+		return outputPlain(opts.gs, extensions, newNumberFormat(opts))
+	}
+
+	// The k6 --quiet flag replaces the default table, not an output asked for explicitly.
+	if opts.gs.Flags.Quiet && !opts.detailed && !opts.brief && len(opts.columns) == 0 && opts.format == "" {
+		return outputModules(opts.gs, extensions)
+	}
+
+	hl := newHighlighter(opts.gs, opts.terms, opts.caseSensitive, opts.match)
+
 	if opts.detailed {
-		return outputDetailed(opts.gs, extensions)
+		return outputDetailed(opts.gs, extensions, hl)
+	}
+
+	layout := tableLayout{
+		columns:    opts.columns,
+		notrunc:    opts.notrunc,
+		longValues: opts.longValues,
+		indicator:  opts.ellipsis.indicator(),
+		numbers:    newNumberFormat(opts),
+	}
+
+	// The wide format is meant for wide terminals and files, where nothing needs to be cut.
+	if opts.format == formatWide {
+		layout.notrunc = true
+	}
+
+	switch {
+	case opts.brief:
+		layout.columns = briefColumns
+	case len(layout.columns) > 0:
+	case opts.format == formatWide, opts.format == formatCSV, opts.format == formatTSV:
+		layout.columns = wideColumns
+	default:
+		layout.columns = defaultColumns
+	}
+
+	if opts.annotate && !slices.Contains(layout.columns, "compat") {
+		// The column goes before the description, which is the one cut to fit the terminal.
+		at := slices.Index(layout.columns, "description")
+		if at < 0 {
+			at = len(layout.columns)
+		}
+
+		layout.columns = slices.Insert(slices.Clone(layout.columns), at, "compat")
+	}
+
+	if slices.Contains(layout.columns, "compat") {
+		compat, err := newCompatFilter(targetK6Version(opts))
+		if err != nil {
+			opts.gs.Logger.Warnf("The k6 version is unknown, the compatibility of the extensions is not checked: %v", err)
+		}
+
+		layout.compat = compat
+	}
+
+	if opts.format == formatCSV {
+		return outputCSV(opts.gs, extensions, layout, opts.delimiter.comma())
+	}
+
+	if opts.format == formatTSV {
+		return outputTSV(opts.gs, extensions, layout)
+	}
+
+	if opts.format == formatHTML {
+		return outputHTML(opts.gs, extensions, layout, fetchedAt)
+	}
+
+	if opts.groupBy != "" {
+		return outputGroupedTable(opts.gs, extensions, opts.groupBy, layout, hl)
+	}
+
+	return outputTable(opts.gs, extensions, layout, hl)
+}
+
+// targetK6Version returns the k6 version the constraints are checked against:
+// the --k6-version flag, or the running k6.
+func targetK6Version(opts options) string {
+	if version := opts.k6Version.String(); version != "" {
+		return version
+	}
+
+	return detectK6Version(opts.gs.Env, debug.ReadBuildInfo)
+}
+
+// catalogURL returns the URL of the catalog matching the running k6 major version.
+func catalogURL(opts options) string {
+	return catalogURLForVersion(detectK6Major(opts.gs.Env, debug.ReadBuildInfo))
+}
+
+// newFetcher returns a catalog fetcher which honors the --strict flag and
+// reports the progress of catalogs with many pages.
+func newFetcher(opts options) *catalogFetcher {
+	var onInvalid invalidEntryFunc
+
+	if !opts.strict {
+		onInvalid = func(name string, err error) {
+			opts.gs.Logger.Warnf("Skipping invalid catalog entry %s: %v", name, err)
+		}
+	}
+
+	fetcher := newCatalogFetcher(onInvalid)
+
+	opts.recorder.attach(fetcher.client)
+	guardNetwork(opts, fetcher.client)
+
+	fetcher.onProgress = func(fetched, total int) {
+		logf := opts.gs.Logger.Debugf
+		if total >= progressMinPages || (total == 0 && fetched >= progressMinPages) {
+			logf = opts.gs.Logger.Infof
+		}
+
+		if total == 0 {
+			logf("Fetched catalog page %d", fetched)
+
+			return
+		}
+
+		logf("Fetched catalog page %d of %d", fetched, total)
 	}
 
-	return outputTable(opts.gs, extensions, opts.brief, opts.notrunc)
+	return fetcher
 }
 
-func filterExtensions(catalog map[string]*extension, kind kind, tier tier) []*extension {
+// fetchCatalog returns the extension catalog of the --catalog source, or of the registry.
+func fetchCatalog(opts options) (map[string]*extension, error) {
+	if opts.catalog == "" {
+		if token := reuseFetchToken(opts); token != "" {
+			return fetchReusable(opts, token, catalogURL(opts))
+		}
+
+		url := catalogURL(opts)
+
+		catalog, err := newFetcher(opts).getExtensionCatalog(opts.gs.Ctx, url)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.summary.fetchedFrom(sourceRegistry, url, "", len(catalog))
+
+		return catalog, nil
+	}
+
+	if path, found := strings.CutPrefix(opts.catalog, snapshotScheme); found && path != "" {
+		catalog, err := importSnapshot(opts.gs, path)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.summary.fetchedFrom(sourceSnapshot, opts.catalog, "", len(catalog))
+
+		return catalog, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", errInvalidCatalogSource, opts.catalog)
+}
+
+// loadCatalog fetches the extension catalog, removes the modules listed twice
+// and merges the local notes into it.
+func loadCatalog(opts options, cfg *config) (map[string]*extension, error) {
+	catalog, err := fetchCatalog(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := prepareCatalog(opts, cfg, catalog); err != nil {
+		return nil, err
+	}
+
+	return catalog, nil
+}
+
+// prepareCatalog removes the modules listed twice from the fetched extensions, or fails
+// with --strict, and completes them: catalog names, prereleases and local notes.
+func prepareCatalog(opts options, cfg *config, catalog map[string]*extension) error {
+	for _, dup := range dedupeModules(catalog) {
+		if opts.strict {
+			return fmt.Errorf("%w: %s as %s and %s",
+				errDuplicateModule, dup.module, dup.kept, strings.Join(dup.dropped, ", "))
+		}
+
+		opts.gs.Logger.Warnf("Module %s is listed as %s and %s in the catalog, using %s",
+			dup.module, dup.kept, strings.Join(dup.dropped, ", "), dup.kept)
+	}
+
+	for name, ext := range catalog {
+		ext.name = name
+	}
+
+	if opts.prerelease {
+		includePrereleases(catalog)
+	}
+
+	return mergeNotes(opts, cfg, catalog)
+}
+
+// includePrereleases makes the highest version of the extensions the latest one,
+// even if it is a prerelease, see --include-prerelease.
+func includePrereleases(catalog map[string]*extension) {
+	for _, ext := range catalog {
+		ext.Latest = findLatest(ext.Versions, true)
+	}
+}
+
+// mergeNotes merges the notes file given by --notes or by the configuration into the catalog.
+func mergeNotes(opts options, cfg *config, catalog map[string]*extension) error {
+	notesPath := opts.notes
+	if notesPath == "" {
+		notesPath = cfg.Notes
+	}
+
+	if notesPath == "" {
+		return nil
+	}
+
+	notes, err := loadNotes(opts.gs, notesPath)
+	if err != nil {
+		return err
+	}
+
+	applyNotes(opts.gs, catalog, notes)
+
+	return nil
+}
+
+// filterExtensions returns the extensions of the catalog passing all the filters.
+func filterExtensions(catalog map[string]*extension, filters ...extensionFilter) []*extension {
 	filtered := make([]*extension, 0)
 
 	for _, ext := range catalog {
@@ -120,7 +805,7 @@ func filterExtensions(catalog map[string]*extension, kind kind, tier tier) []*ex
 			continue
 		}
 
-		if kind.filter(ext) && tier.filter(ext) {
+		if allFilters(ext, filters) {
 			filtered = append(filtered, ext)
 		}
 	}
@@ -128,25 +813,46 @@ func filterExtensions(catalog map[string]*extension, kind kind, tier tier) []*ex
 	return filtered
 }
 
-func sortExtensions(extensions []*extension) {
-	// Sort filtered extensions by tier (official first),
-	// then by type (javascript, output, subcommand),
-	// then alphabetically by module name.
-	sort.Slice(extensions, func(i, j int) bool {
-		// First, sort by tier (official before community)
-		if extensions[i].Tier != extensions[j].Tier {
-			return extensions[i].Tier > extensions[j].Tier
+func allFilters(ext *extension, filters []extensionFilter) bool {
+	for _, f := range filters {
+		if !f.filter(ext) {
+			return false
 		}
+	}
 
-		// Then, sort by type (javascript, output, subcommand)
-		typeI := extensionType(extensions[i])
-		typeJ := extensionType(extensions[j])
+	return true
+}
 
-		if typeI != typeJ {
-			return typeI < typeJ
-		}
+// tierRank orders the tiers: official first, community last and the other tiers in between.
+func tierRank(t string) int {
+	switch tier(t) {
+	case tierOfficial:
+		return 0
+	case tierCommunity:
+		return 2 //nolint:mnd
+	default:
+		return 1
+	}
+}
+
+// sortExtensions sorts the extensions in the given order, or in the default order
+// (official first, then by type and module path) if none is given.
+func sortExtensions(extensions []*extension, order sortOrder) {
+	if len(order) == 0 {
+		order = defaultSortOrder
+	}
+
+	slices.SortStableFunc(extensions, order.compare)
+}
+
+// pageExtensions returns at most limit extensions after skipping the first offset ones.
+// A zero limit means no limit.
+func pageExtensions(extensions []*extension, offset, limit int) []*extension {
+	extensions = extensions[min(offset, len(extensions)):]
+
+	if limit > 0 && limit < len(extensions) {
+		extensions = extensions[:limit]
+	}
 
-		// Finally, sort alphabetically by module name
-		return extensions[i].Module < extensions[j].Module
-	})
+	return extensions
 }
@@ -0,0 +1,78 @@
+package explore
+
+import (
+	"slices"
+
+	"github.com/spf13/cobra"
+)
+
+// catalogTiers returns the sorted list of tiers present in the catalog.
+func catalogTiers(catalog map[string]*extension) []string {
+	tiers := make([]string, 0)
+
+	for _, ext := range catalog {
+		if ext.Tier != "" && !slices.Contains(tiers, ext.Tier) {
+			tiers = append(tiers, ext.Tier)
+		}
+	}
+
+	slices.Sort(tiers)
+
+	return tiers
+}
+
+// catalogKinds returns the extension types which have at least one extension in the catalog:
+// the built-in types first, followed by the other capabilities in alphabetical order.
+func catalogKinds(catalog map[string]*extension) []string {
+	kinds := make([]string, 0, len(kindValues))
+	others := make([]string, 0)
+
+	for _, ext := range catalog {
+		for _, value := range extensionKinds(ext) {
+			if !slices.Contains(kindValues, value) && !slices.Contains(others, value) {
+				others = append(others, value)
+			}
+		}
+	}
+
+	for _, value := range kindValues {
+		k := kind(value)
+
+		for _, ext := range catalog {
+			if k.filter(ext) {
+				kinds = append(kinds, value)
+
+				break
+			}
+		}
+	}
+
+	slices.Sort(others)
+
+	return append(kinds, others...)
+}
+
+// registerCompletions completes the --tier and --type flag values from the live catalog,
+// falling back to the built-in values when the catalog cannot be fetched.
+func registerCompletions(cmd *cobra.Command, opts *options) {
+	complete := func(fromCatalog func(map[string]*extension) []string, fallback []string) func(
+		*cobra.Command, []string, string,
+	) ([]string, cobra.ShellCompDirective) {
+		return func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+			cfg, err := loadConfig(opts.gs)
+			if err != nil {
+				return fallback, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			catalog, err := loadCatalog(*opts, cfg)
+			if err != nil {
+				return fallback, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return fromCatalog(catalog), cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+
+	_ = cmd.RegisterFlagCompletionFunc("tier", complete(catalogTiers, tierValues))
+	_ = cmd.RegisterFlagCompletionFunc("type", complete(catalogKinds, kindValues))
+}
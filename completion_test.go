@@ -0,0 +1,35 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogTiers(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"a": {Tier: "official"},
+		"b": {Tier: "partner"},
+		"c": {Tier: "community"},
+		"d": {Tier: "official"},
+		"e": {},
+	}
+
+	require.Equal(t, []string{"community", "official", "partner"}, catalogTiers(catalog))
+	require.Empty(t, catalogTiers(map[string]*extension{}))
+}
+
+func TestCatalogKinds(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"a": {Imports: []string{"k6/x/faker"}},
+		"b": {Subcommands: []string{"dashboard"}},
+		"c": {Capabilities: map[string][]string{"secret-source": {"vault"}, "browser": {"chromium"}}},
+		"d": {Capabilities: map[string][]string{"secret-source": {"env"}}},
+	}
+
+	require.Equal(t, []string{"javascript", "subcommand", "browser", "secret-source"}, catalogKinds(catalog))
+}
@@ -0,0 +1,69 @@
+package explore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+var errInvalidConfig = errors.New("invalid configuration file")
+
+const (
+	// configEnvVar overrides the location of the configuration file,
+	// which makes it easy to share a single file across a team.
+	configEnvVar = "K6_EXPLORE_CONFIG"
+
+	configFileName = "explore.json"
+)
+
+// config holds the user-level settings of the explore subcommand.
+type config struct {
+	// Bundles maps a bundle name to its members. Members are referenced by
+	// catalog name (e.g. xk6-faker) or by module path.
+	Bundles map[string][]string `json:"bundles,omitempty"`
+
+	// Notes is the path of the notes file, see the --notes flag.
+	Notes string `json:"notes,omitempty"`
+
+	// LongValues prints the type and tier in full words in the table, see the --long-values flag.
+	LongValues bool `json:"longValues,omitempty"`
+}
+
+// configPath returns the path of the configuration file: the value of the
+// K6_EXPLORE_CONFIG environment variable or explore.json in the k6 user
+// configuration directory.
+func configPath(gs *state.GlobalState) string {
+	if path := gs.Env[configEnvVar]; path != "" {
+		return path
+	}
+
+	return filepath.Join(gs.UserOSConfigDir, "k6", configFileName)
+}
+
+// loadConfig reads the configuration file. A missing file is not an error,
+// an empty configuration is returned instead.
+func loadConfig(gs *state.GlobalState) (*config, error) {
+	path := configPath(gs)
+
+	data, err := fsext.ReadFile(gs.FS, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return new(config), nil
+		}
+
+		return nil, err
+	}
+
+	cfg := new(config)
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("%w %s: %w", errInvalidConfig, path, err)
+	}
+
+	return cfg, nil
+}
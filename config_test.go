@@ -0,0 +1,63 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+
+		cfg, err := loadConfig(ts.GlobalState)
+		require.NoError(t, err)
+		require.Empty(t, cfg.Bundles)
+	})
+
+	t.Run("default location", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+		ts.UserOSConfigDir = "/home/user/.config"
+
+		data := []byte(`{"bundles":{"data":["xk6-faker"]}}`)
+		require.NoError(t, fsext.WriteFile(ts.FS, "/home/user/.config/k6/explore.json", data, 0o600))
+
+		cfg, err := loadConfig(ts.GlobalState)
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{"data": {"xk6-faker"}}, cfg.Bundles)
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+		ts.Env[configEnvVar] = "/team/explore.json"
+
+		data := []byte(`{"bundles":{"data":["xk6-faker"]}}`)
+		require.NoError(t, fsext.WriteFile(ts.FS, "/team/explore.json", data, 0o600))
+
+		cfg, err := loadConfig(ts.GlobalState)
+		require.NoError(t, err)
+		require.Contains(t, cfg.Bundles, "data")
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+		ts.Env[configEnvVar] = "/team/explore.json"
+
+		require.NoError(t, fsext.WriteFile(ts.FS, "/team/explore.json", []byte("{"), 0o600))
+
+		_, err := loadConfig(ts.GlobalState)
+		require.ErrorIs(t, err, errInvalidConfig)
+	})
+}
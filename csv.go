@@ -0,0 +1,67 @@
+package explore
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+// outputCSV writes the columns of the layout as CSV, with the column names as header.
+// The values are neither abbreviated nor truncated, as they are meant for spreadsheets.
+func outputCSV(gs *state.GlobalState, extensions []*extension, layout tableLayout, comma rune) error {
+	w := csv.NewWriter(gs.Stdout)
+	w.Comma = comma
+
+	for _, record := range records(extensions, layout) {
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
+
+// outputTSV writes the columns of the layout separated by single tabs, with the column
+// names as header, for awk and cut. Tabs and line breaks in the values are replaced by
+// spaces, as TSV has no quoting.
+func outputTSV(gs *state.GlobalState, extensions []*extension, layout tableLayout) error {
+	for _, record := range records(extensions, layout) {
+		for i := range record {
+			record[i] = cleanField(record[i])
+		}
+
+		_, _ = fmt.Fprintln(gs.Stdout, strings.Join(record, "\t"))
+	}
+
+	return nil
+}
+
+// cleanField replaces the tabs and line breaks of a tab-separated field by spaces.
+func cleanField(s string) string {
+	return strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ").Replace(s)
+}
+
+// records returns the header and the rows of the columns of the layout, with the values
+// neither abbreviated nor truncated.
+func records(extensions []*extension, layout tableLayout) [][]string {
+	layout.longValues, layout.notrunc = true, true
+
+	records := make([][]string, 0, len(extensions)+1)
+	records = append(records, layout.columns)
+
+	for _, ext := range extensions {
+		record := make([]string, 0, len(layout.columns))
+
+		for _, name := range layout.columns {
+			record = append(record, tableColumns[name].value(ext, layout))
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
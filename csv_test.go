@@ -0,0 +1,55 @@
+package explore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestOutputCSV(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{
+			Module: "github.com/grafana/xk6-faker", Tier: "official", Latest: "v0.4.4", Imports: []string{"k6/x/faker"},
+			Description: `Generate "fake" data, e.g. names`, Repo: &repository{Stars: 120, License: "AGPL-3.0"},
+		},
+		{Module: "github.com/example/xk6-output-foo", Latest: "v1.0.0", Outputs: []string{"foo"}, Description: "Foo"},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, output(options{gs: ts.GlobalState, format: formatCSV}, extensions, time.Now()))
+	require.Equal(t, `module,latest,type,tier,imports,outputs,subcommands,stars,license,constraints,description
+github.com/grafana/xk6-faker,v0.4.4,JavaScript,Official,k6/x/faker,,,120,AGPL-3.0,,"Generate ""fake"" data, e.g. names"
+github.com/example/xk6-output-foo,v1.0.0,Output,Community,,foo,,,,,Foo
+`, ts.Stdout.String())
+
+	ts = cmdtests.NewGlobalTestState(t)
+
+	opts := options{gs: ts.GlobalState, format: formatCSV, delimiter: ';', columns: columnList{"module", "imports"}}
+
+	require.NoError(t, output(opts, extensions, time.Now()))
+	require.Equal(t, "module;imports\ngithub.com/grafana/xk6-faker;k6/x/faker\ngithub.com/example/xk6-output-foo;\n",
+		ts.Stdout.String())
+}
+
+func TestOutputTSV(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Tier: "official", Imports: []string{"k6/x/faker"}, Description: "Fake\tdata\nfor tests"},
+		{Module: "github.com/example/xk6-output-foo", Outputs: []string{"foo"}},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	opts := options{gs: ts.GlobalState, format: formatTSV, columns: columnList{"module", "tier", "imports", "description"}}
+
+	require.NoError(t, output(opts, extensions, time.Now()))
+	require.Equal(t, "module\ttier\timports\tdescription\n"+
+		"github.com/grafana/xk6-faker\tOfficial\tk6/x/faker\tFake data for tests\n"+
+		"github.com/example/xk6-output-foo\tCommunity\t\t\n", ts.Stdout.String())
+}
@@ -0,0 +1,138 @@
+package explore
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+const (
+	deprecationsHelpShort = "List the deprecated extensions with their deprecation timeline"
+	deprecationsHelpLong  = `List the deprecated extensions with their deprecation timeline.
+
+For each deprecated extension, the deprecation date, the planned removal date and
+the extension to migrate to are listed when the registry publishes them, so that
+the migrations can be planned ahead of the removals. Extensions whose repository
+is archived are listed as well, as they are no longer maintained.
+
+The extensions are sorted by planned removal date, the earliest first, then those
+without a planned removal.
+`
+	deprecationsHelpExample = `
+# List the deprecated extensions:
+k6 x explore deprecations
+
+# Output as JSON:
+k6 x explore deprecations --json
+`
+)
+
+// deprecationEntry is a deprecated extension with its deprecation timeline.
+type deprecationEntry struct {
+	Module string `json:"module"`
+	// Reason is deprecated or archived.
+	Reason string `json:"reason"`
+	deprecation
+}
+
+// newDeprecationsCommand creates the "deprecations" subcommand of explore.
+func newDeprecationsCommand(opts *options) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "deprecations",
+		Short:   deprecationsHelpShort,
+		Long:    deprecationsHelpLong,
+		Example: deprecationsHelpExample,
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cfg, err := loadConfig(opts.gs)
+			if err != nil {
+				return err
+			}
+
+			catalog, err := loadCatalog(*opts, cfg)
+			if err != nil {
+				return err
+			}
+
+			entries := findDeprecations(catalog)
+
+			if asJSON {
+				return outputJSON(opts.gs, entries)
+			}
+
+			return outputDeprecations(opts.gs, entries)
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+
+	return cmd
+}
+
+// findDeprecations returns the deprecated extensions of the catalog, sorted by planned
+// removal date, the earliest first, then those without a planned removal, then by module path.
+func findDeprecations(catalog map[string]*extension) []*deprecationEntry {
+	entries := make([]*deprecationEntry, 0)
+
+	for _, ext := range catalog {
+		if !isDeprecated(ext) {
+			continue
+		}
+
+		entry := &deprecationEntry{Module: ext.Module, Reason: "deprecated"}
+
+		if ext.Deprecation != nil {
+			entry.deprecation = *ext.Deprecation
+		}
+
+		if !ext.Deprecated && ext.Deprecation == nil {
+			entry.Reason = "archived"
+		}
+
+		entries = append(entries, entry)
+	}
+
+	unplanned := func(entry *deprecationEntry) int {
+		if entry.Removal == "" {
+			return 1
+		}
+
+		return 0
+	}
+
+	slices.SortFunc(entries, func(a, b *deprecationEntry) int {
+		return cmp.Or(
+			cmp.Compare(unplanned(a), unplanned(b)),
+			strings.Compare(a.Removal, b.Removal),
+			strings.Compare(a.Module, b.Module),
+		)
+	})
+
+	return entries
+}
+
+func outputDeprecations(gs *state.GlobalState, entries []*deprecationEntry) error {
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintln(gs.Stdout, "No deprecated extensions found.")
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	_, _ = fmt.Fprintln(w, "MODULE\tREASON\tSINCE\tREMOVAL\tREPLACEMENT")
+
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			entry.Module, entry.Reason, valueOrNone(entry.Since), valueOrNone(entry.Removal), valueOrNone(entry.Replacement))
+	}
+
+	return w.Flush()
+}
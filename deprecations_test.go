@@ -0,0 +1,68 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestFindDeprecations(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker":   {Module: "github.com/grafana/xk6-faker"},
+		"xk6-archive": {Module: "github.com/example/xk6-archive", Repo: &repository{Archived: true}},
+		"xk6-old":     {Module: "github.com/example/xk6-old", Deprecated: true},
+		"xk6-late": {
+			Module:      "github.com/example/xk6-late",
+			Deprecation: &deprecation{Since: "2025-01-01", Removal: "2026-06-30"},
+		},
+		"xk6-soon": {
+			Module:      "github.com/example/xk6-soon",
+			Deprecated:  true,
+			Deprecation: &deprecation{Removal: "2025-12-31", Replacement: "github.com/grafana/xk6-soon"},
+		},
+	}
+
+	require.Equal(t, []*deprecationEntry{
+		{
+			Module:      "github.com/example/xk6-soon",
+			Reason:      "deprecated",
+			deprecation: deprecation{Removal: "2025-12-31", Replacement: "github.com/grafana/xk6-soon"},
+		},
+		{
+			Module:      "github.com/example/xk6-late",
+			Reason:      "deprecated",
+			deprecation: deprecation{Since: "2025-01-01", Removal: "2026-06-30"},
+		},
+		{Module: "github.com/example/xk6-archive", Reason: "archived"},
+		{Module: "github.com/example/xk6-old", Reason: "deprecated"},
+	}, findDeprecations(catalog))
+
+	require.Empty(t, findDeprecations(map[string]*extension{"xk6-faker": catalog["xk6-faker"]}))
+}
+
+func TestOutputDeprecations(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputDeprecations(ts.GlobalState, []*deprecationEntry{
+		{
+			Module:      "github.com/example/xk6-soon",
+			Reason:      "deprecated",
+			deprecation: deprecation{Since: "2025-01-01", Removal: "2025-12-31", Replacement: "github.com/grafana/xk6-soon"},
+		},
+		{Module: "github.com/example/xk6-archive", Reason: "archived"},
+	}))
+	require.Equal(t, `MODULE                          REASON      SINCE       REMOVAL     REPLACEMENT
+github.com/example/xk6-soon     deprecated  2025-01-01  2025-12-31  github.com/grafana/xk6-soon
+github.com/example/xk6-archive  archived    -           -           -
+`, ts.Stdout.String())
+
+	ts = cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputDeprecations(ts.GlobalState, nil))
+	require.Equal(t, "No deprecated extensions found.\n", ts.Stdout.String())
+}
@@ -0,0 +1,267 @@
+package explore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+var errFetchGoMod = errors.New("failed to fetch go.mod")
+
+const (
+	defaultGoProxy = "https://proxy.golang.org"
+
+	depsHelpShort = "Analyze dependency overlaps between extensions"
+	depsHelpLong  = `Analyze the Go dependencies shared by the given extensions.
+
+The go.mod files of the latest versions of the extensions are fetched from the
+Go module proxy (GOPROXY), and the dependencies required by more than one
+extension at different versions are reported together with the version the
+minimal version selection (MVS) will pick in a combined build.
+
+Such dependencies are the usual source of surprises when building a k6 binary
+with several extensions: every extension except the one requiring the highest
+version is built against a dependency version it was never tested with.
+
+Extensions can be referenced by catalog name, module path or import path.
+`
+	depsHelpExample = `
+# Check the dependencies shared by two extensions:
+k6 x explore deps xk6-faker xk6-sql
+
+# Output as JSON:
+k6 x explore deps xk6-faker xk6-sql --json
+`
+
+	depsHeader = "DEPENDENCY\tSELECTED\tREQUIRED BY\n"
+)
+
+// requirement is a dependency version required by an extension.
+type requirement struct {
+	Extension string `json:"extension"`
+	Version   string `json:"version"`
+}
+
+// overlap is a dependency required by several extensions at different versions.
+type overlap struct {
+	Module       string        `json:"module"`
+	Selected     string        `json:"selected"`
+	Requirements []requirement `json:"requirements"`
+}
+
+// newDepsCommand creates the "deps" subcommand of explore.
+func newDepsCommand(opts *options) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "deps <name> <name>...",
+		Short:   depsHelpShort,
+		Long:    depsHelpLong,
+		Example: depsHelpExample,
+		Args:    cobra.MinimumNArgs(2), //nolint:mnd
+		RunE: func(_ *cobra.Command, args []string) error {
+			cfg, err := loadConfig(opts.gs)
+			if err != nil {
+				return err
+			}
+
+			catalog, err := loadCatalog(*opts, cfg)
+			if err != nil {
+				return err
+			}
+
+			selected := make(map[string]*extension, len(args))
+
+			for _, query := range args {
+				name, ext := findExtension(catalog, query)
+				if ext == nil {
+					return fmt.Errorf("%w: %s", errExtensionNotFound, query)
+				}
+
+				selected[name] = ext
+			}
+
+			proxy := newGoProxyClient(opts.gs)
+
+			opts.recorder.attach(proxy.client)
+			guardNetwork(*opts, proxy.client)
+
+			overlaps, err := analyzeDependencies(opts.gs.Ctx, proxy, selected)
+			if err != nil {
+				return err
+			}
+
+			if asJSON {
+				return outputJSON(opts.gs, overlaps)
+			}
+
+			return outputOverlaps(opts.gs, overlaps)
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+
+	return cmd
+}
+
+// goProxyClient fetches module metadata from a Go module proxy.
+type goProxyClient struct {
+	client *http.Client
+	proxy  string
+}
+
+// newGoProxyClient returns a client for the first proxy URL of the GOPROXY environment
+// variable, or for the public Go module proxy.
+func newGoProxyClient(gs *state.GlobalState) *goProxyClient {
+	proxy := defaultGoProxy
+
+	for entry := range strings.FieldsFuncSeq(gs.Env["GOPROXY"], func(r rune) bool { return r == ',' || r == '|' }) {
+		if strings.HasPrefix(entry, "https://") || strings.HasPrefix(entry, "http://") {
+			proxy = entry
+
+			break
+		}
+	}
+
+	return &goProxyClient{
+		client: &http.Client{Timeout: httpRequestTimeout},
+		proxy:  strings.TrimSuffix(proxy, "/"),
+	}
+}
+
+// get fetches a path of the module proxy protocol for the module, e.g. "@v/list".
+func (c *goProxyClient) get(ctx context.Context, modulePath string, path string) ([]byte, error) {
+	escaped, err := module.EscapePath(modulePath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.proxy+"/"+escaped+"/"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", "xk6-subcommand-explore")
+
+	resp, err := c.client.Do(req) //nolint:gosec // fetches from the configured Go module proxy
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s %s: %w", errFetchGoMod, modulePath, path, newStatusError(resp))
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// goMod fetches and parses the go.mod file of a module version.
+func (c *goProxyClient) goMod(ctx context.Context, modulePath string, version string) (*modfile.File, error) {
+	escaped, err := module.EscapeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.get(ctx, modulePath, "@v/"+escaped+".mod")
+	if err != nil {
+		return nil, err
+	}
+
+	return modfile.ParseLax(modulePath+"@"+version+"/go.mod", data, nil)
+}
+
+// analyzeDependencies reports the dependencies required by more than one of the selected
+// extensions at different versions, using the go.mod files of their latest versions.
+func analyzeDependencies(
+	ctx context.Context,
+	proxy *goProxyClient,
+	selected map[string]*extension,
+) ([]*overlap, error) {
+	required := make(map[string][]requirement)
+
+	for name, ext := range selected {
+		if ext.Latest == "" {
+			return nil, fmt.Errorf("%w: %s has no released version", errFetchGoMod, name)
+		}
+
+		file, err := proxy.goMod(ctx, ext.Module, ext.Latest)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, req := range file.Require {
+			required[req.Mod.Path] = append(required[req.Mod.Path], requirement{Extension: name, Version: req.Mod.Version})
+		}
+	}
+
+	overlaps := make([]*overlap, 0)
+
+	for modulePath, reqs := range required {
+		if len(reqs) < 2 || !differentVersions(reqs) {
+			continue
+		}
+
+		slices.SortFunc(reqs, func(a, b requirement) int { return strings.Compare(a.Extension, b.Extension) })
+
+		selectedVersion := reqs[0].Version
+		for _, req := range reqs[1:] {
+			if semver.Compare(req.Version, selectedVersion) > 0 {
+				selectedVersion = req.Version
+			}
+		}
+
+		overlaps = append(overlaps, &overlap{Module: modulePath, Selected: selectedVersion, Requirements: reqs})
+	}
+
+	slices.SortFunc(overlaps, func(a, b *overlap) int { return strings.Compare(a.Module, b.Module) })
+
+	return overlaps, nil
+}
+
+func differentVersions(reqs []requirement) bool {
+	for _, req := range reqs[1:] {
+		if req.Version != reqs[0].Version {
+			return true
+		}
+	}
+
+	return false
+}
+
+func outputOverlaps(gs *state.GlobalState, overlaps []*overlap) error {
+	if len(overlaps) == 0 {
+		_, _ = fmt.Fprintln(gs.Stdout, "No dependency is required at different versions.")
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	_, _ = w.Write([]byte(depsHeader))
+
+	for _, o := range overlaps {
+		reqs := make([]string, 0, len(o.Requirements))
+		for _, req := range o.Requirements {
+			reqs = append(reqs, req.Extension+" "+req.Version)
+		}
+
+		_, _ = w.Write([]byte(o.Module + "\t" + o.Selected + "\t" + strings.Join(reqs, ", ") + "\n"))
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,104 @@
+package explore
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestNewGoProxyClient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		goproxy string
+		want    string
+	}{
+		{name: "unset", goproxy: "", want: defaultGoProxy},
+		{name: "single", goproxy: "https://proxy.example.com/", want: "https://proxy.example.com"},
+		{name: "list", goproxy: "direct,https://proxy.example.com|https://other.example.com", want: "https://proxy.example.com"},
+		{name: "no proxy", goproxy: "off", want: defaultGoProxy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := cmdtests.NewGlobalTestState(t)
+			ts.Env["GOPROXY"] = tt.goproxy
+
+			require.Equal(t, tt.want, newGoProxyClient(ts.GlobalState).proxy)
+		})
+	}
+}
+
+func TestAnalyzeDependencies(t *testing.T) {
+	t.Parallel()
+
+	goMods := map[string]string{
+		"/github.com/grafana/xk6-faker/@v/v0.4.4.mod": `module github.com/grafana/xk6-faker
+
+require (
+	github.com/brianvoe/gofakeit/v7 v7.1.2
+	github.com/stretchr/testify v1.9.0
+	go.k6.io/k6 v0.55.0
+)
+`,
+		"/github.com/grafana/xk6-sql/@v/v1.0.0.mod": `module github.com/grafana/xk6-sql
+
+require (
+	github.com/stretchr/testify v1.9.0
+	go.k6.io/k6 v0.57.0
+)
+`,
+		"/github.com/!example/xk6-kafka/@v/v1.0.0.mod": `module github.com/Example/xk6-kafka
+
+require go.k6.io/k6 v0.56.0
+`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		goMod, found := goMods[r.URL.Path]
+		if !found {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(goMod))
+	}))
+	t.Cleanup(srv.Close)
+
+	proxy := &goProxyClient{client: srv.Client(), proxy: srv.URL}
+
+	overlaps, err := analyzeDependencies(t.Context(), proxy, map[string]*extension{
+		"xk6-faker": {Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4"},
+		"xk6-sql":   {Module: "github.com/grafana/xk6-sql", Latest: "v1.0.0"},
+		"xk6-kafka": {Module: "github.com/Example/xk6-kafka", Latest: "v1.0.0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*overlap{{
+		Module:   "go.k6.io/k6",
+		Selected: "v0.57.0",
+		Requirements: []requirement{
+			{Extension: "xk6-faker", Version: "v0.55.0"},
+			{Extension: "xk6-kafka", Version: "v0.56.0"},
+			{Extension: "xk6-sql", Version: "v0.57.0"},
+		},
+	}}, overlaps)
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputOverlaps(ts.GlobalState, overlaps))
+	require.Equal(t, `DEPENDENCY   SELECTED  REQUIRED BY
+go.k6.io/k6  v0.57.0   xk6-faker v0.55.0, xk6-kafka v0.56.0, xk6-sql v0.57.0
+`, ts.Stdout.String())
+
+	_, err = analyzeDependencies(t.Context(), proxy, map[string]*extension{
+		"xk6-missing": {Module: "github.com/grafana/xk6-missing", Latest: "v1.0.0"},
+	})
+	require.ErrorIs(t, err, errFetchGoMod)
+}
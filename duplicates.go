@@ -0,0 +1,320 @@
+package explore
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+	"golang.org/x/mod/module"
+)
+
+const (
+	duplicatesHelpShort = "Detect extensions which look like forks or renames of each other"
+	duplicatesHelpLong  = `Detect the catalog entries which appear to be forks or renames of each other.
+
+Two extensions are grouped when they provide the same JavaScript import path,
+output name or subcommand name, when their repositories have the same name, or
+when their descriptions are nearly identical. Overlapping version sets are
+reported as an additional hint.
+
+In each group, the extension most likely to be the canonical maintained variant
+is named first: not deprecated, of the highest tier, with the most repository
+stars and the most recent update. Deprecated extensions are included, as the
+renamed or abandoned variants often are.
+`
+	duplicatesHelpExample = `
+# List the groups of suspected duplicates:
+k6 x explore duplicates
+
+# Output as JSON:
+k6 x explore duplicates --json
+`
+
+	// similarDescription is the minimum share of common words of similar descriptions.
+	similarDescription = 0.8
+
+	// overlappingVersions is the minimum share of common versions of overlapping version sets.
+	overlappingVersions = 0.5
+
+	// minCommonVersions is the minimum number of common versions of overlapping version sets,
+	// as most extensions share their first versions, e.g. v0.1.0.
+	minCommonVersions = 3
+)
+
+// duplicateGroup is a group of extensions which appear to be forks or renames of each other.
+type duplicateGroup struct {
+	// Canonical is the module path of the extension most likely to be the maintained variant.
+	Canonical string `json:"canonical"`
+	// Duplicates are the module paths of the other extensions of the group.
+	Duplicates []string `json:"duplicates"`
+	// Reasons explain why the extensions are grouped, e.g. same import k6/x/faker.
+	Reasons []string `json:"reasons"`
+}
+
+// newDuplicatesCommand creates the "duplicates" subcommand of explore.
+func newDuplicatesCommand(opts *options) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "duplicates",
+		Short:   duplicatesHelpShort,
+		Long:    duplicatesHelpLong,
+		Example: duplicatesHelpExample,
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cfg, err := loadConfig(opts.gs)
+			if err != nil {
+				return err
+			}
+
+			catalog, err := loadCatalog(*opts, cfg)
+			if err != nil {
+				return err
+			}
+
+			groups := findDuplicates(catalog)
+
+			if asJSON {
+				return outputJSON(opts.gs, groups)
+			}
+
+			return outputDuplicates(opts.gs, groups)
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+
+	return cmd
+}
+
+// findDuplicates groups the extensions of the catalog which appear to be forks or renames
+// of each other. The groups are sorted by the module path of their canonical extension.
+func findDuplicates(catalog map[string]*extension) []*duplicateGroup {
+	extensions := make([]*extension, 0, len(catalog))
+
+	for _, ext := range catalog {
+		if ext.Module != "go.k6.io/k6/v2" {
+			extensions = append(extensions, ext)
+		}
+	}
+
+	slices.SortFunc(extensions, func(a, b *extension) int { return strings.Compare(a.Module, b.Module) })
+
+	// The groups are the connected components of the pairs of duplicates.
+	parent := make([]int, len(extensions))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var root func(i int) int
+
+	root = func(i int) int {
+		if parent[i] != i {
+			parent[i] = root(parent[i])
+		}
+
+		return parent[i]
+	}
+
+	reasons := make(map[int]map[string]bool)
+
+	for i := range extensions {
+		for j := i + 1; j < len(extensions); j++ {
+			found := duplicateReasons(extensions[i], extensions[j])
+			if len(found) == 0 {
+				continue
+			}
+
+			ri, rj := root(i), root(j)
+			if ri != rj {
+				parent[rj] = ri
+
+				for reason := range reasons[rj] {
+					addReason(reasons, ri, reason)
+				}
+
+				delete(reasons, rj)
+			}
+
+			for _, reason := range found {
+				addReason(reasons, ri, reason)
+			}
+		}
+	}
+
+	members := make(map[int][]*extension)
+
+	for i, ext := range extensions {
+		r := root(i)
+		members[r] = append(members[r], ext)
+	}
+
+	groups := make([]*duplicateGroup, 0)
+
+	for r, exts := range members {
+		if len(exts) < 2 { //nolint:mnd
+			continue
+		}
+
+		slices.SortFunc(exts, compareCanonical)
+
+		group := &duplicateGroup{Canonical: exts[0].Module, Reasons: slices.Sorted(maps.Keys(reasons[r]))}
+
+		for _, ext := range exts[1:] {
+			group.Duplicates = append(group.Duplicates, ext.Module)
+		}
+
+		groups = append(groups, group)
+	}
+
+	slices.SortFunc(groups, func(a, b *duplicateGroup) int { return strings.Compare(a.Canonical, b.Canonical) })
+
+	return groups
+}
+
+func addReason(reasons map[int]map[string]bool, group int, reason string) {
+	if reasons[group] == nil {
+		reasons[group] = make(map[string]bool)
+	}
+
+	reasons[group][reason] = true
+}
+
+// duplicateReasons returns why the two extensions appear to be forks or renames of each other,
+// or nil if they don't. Overlapping versions are only reported along with another reason.
+func duplicateReasons(a, b *extension) []string {
+	var reasons []string
+
+	for _, shared := range []struct {
+		what           string
+		namesA, namesB []string
+	}{
+		{"import", a.Imports, b.Imports},
+		{"output", a.Outputs, b.Outputs},
+		{"subcommand", a.Subcommands, b.Subcommands},
+	} {
+		for _, name := range shared.namesA {
+			if slices.Contains(shared.namesB, name) {
+				reasons = append(reasons, "same "+shared.what+" "+name)
+			}
+		}
+	}
+
+	if nameA := repositoryName(a); nameA != "" && nameA == repositoryName(b) {
+		reasons = append(reasons, "same repository name "+nameA)
+	}
+
+	if similarity(words(a.Description), words(b.Description)) >= similarDescription {
+		reasons = append(reasons, "similar description")
+	}
+
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	common := 0
+
+	for _, v := range a.Versions {
+		if slices.Contains(b.Versions, v) {
+			common++
+		}
+	}
+
+	if common >= minCommonVersions &&
+		float64(common)/float64(len(a.Versions)+len(b.Versions)-common) >= overlappingVersions {
+		reasons = append(reasons, "overlapping versions")
+	}
+
+	return reasons
+}
+
+// repositoryName returns the lowercase last element of the module path, without
+// the major version suffix, e.g. xk6-faker for github.com/grafana/xk6-faker/v2.
+func repositoryName(ext *extension) string {
+	prefix, _, ok := module.SplitPathVersion(ext.Module)
+	if !ok {
+		prefix = ext.Module
+	}
+
+	if !strings.Contains(prefix, "/") {
+		return ""
+	}
+
+	return strings.ToLower(path.Base(prefix))
+}
+
+// words returns the set of lowercase words of the text.
+func words(text string) map[string]bool {
+	set := make(map[string]bool)
+
+	for word := range strings.FieldsFuncSeq(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		set[word] = true
+	}
+
+	return set
+}
+
+// similarity returns the share of common words of two word sets, 0 if either is empty.
+func similarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	common := 0
+
+	for word := range a {
+		if b[word] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(a)+len(b)-common)
+}
+
+// compareCanonical orders the extensions from the most to the least likely canonical variant:
+// not deprecated first, then by tier, stars, last update and module path.
+func compareCanonical(a, b *extension) int {
+	deprecated := func(ext *extension) int {
+		if isDeprecated(ext) {
+			return 1
+		}
+
+		return 0
+	}
+
+	return cmp.Or(
+		cmp.Compare(deprecated(a), deprecated(b)),
+		cmp.Compare(tierRank(normalizedTier(a)), tierRank(normalizedTier(b))),
+		cmp.Compare(repoStars(b), repoStars(a)),
+		cmp.Compare(repoTimestamp(b), repoTimestamp(a)),
+		strings.Compare(a.Module, b.Module),
+	)
+}
+
+func outputDuplicates(gs *state.GlobalState, groups []*duplicateGroup) error {
+	if len(groups) == 0 {
+		_, _ = fmt.Fprintln(gs.Stdout, "No suspected duplicates found.")
+
+		return nil
+	}
+
+	for _, group := range groups {
+		_, _ = fmt.Fprintf(gs.Stdout, "%s (canonical)\n", group.Canonical)
+
+		for _, duplicate := range group.Duplicates {
+			_, _ = fmt.Fprintf(gs.Stdout, "  %s\n", duplicate)
+		}
+
+		_, _ = fmt.Fprintf(gs.Stdout, "  reasons: %s\n\n", strings.Join(group.Reasons, ", "))
+	}
+
+	return nil
+}
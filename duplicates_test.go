@@ -0,0 +1,86 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker": {
+			Module:      "github.com/grafana/xk6-faker",
+			Tier:        "official",
+			Description: "Generate fake data in your tests",
+			Imports:     []string{"k6/x/faker"},
+			Versions:    []string{"v0.1.0", "v0.2.0", "v0.3.0", "v0.4.0"},
+		},
+		"fork": {
+			Module:      "github.com/example/xk6-faker",
+			Description: "Generate fake data in your tests!",
+			Imports:     []string{"k6/x/faker"},
+			Versions:    []string{"v0.1.0", "v0.2.0", "v0.3.0"},
+		},
+		"renamed": {
+			Module:      "github.com/example/xk6-fake-data",
+			Description: "Generate fake data in your tests",
+			Imports:     []string{"k6/x/fakedata"},
+			Repo:        &repository{Archived: true, Stars: 1000},
+		},
+		"xk6-sql": {
+			Module:      "github.com/grafana/xk6-sql",
+			Tier:        "official",
+			Description: "Load-test SQL Servers",
+			Imports:     []string{"k6/x/sql"},
+			Versions:    []string{"v0.1.0", "v0.2.0", "v0.3.0"},
+		},
+		"xk6-sql-v2": {
+			Module:      "github.com/example/xk6-sql/v2",
+			Description: "SQL for k6",
+			Imports:     []string{"k6/x/sql2"},
+		},
+		"k6": {Module: "go.k6.io/k6/v2", Imports: []string{"k6/x/faker"}},
+	}
+
+	require.Equal(t, []*duplicateGroup{
+		{
+			Canonical:  "github.com/grafana/xk6-faker",
+			Duplicates: []string{"github.com/example/xk6-faker", "github.com/example/xk6-fake-data"},
+			Reasons: []string{
+				"overlapping versions", "same import k6/x/faker", "same repository name xk6-faker", "similar description",
+			},
+		},
+		{
+			Canonical:  "github.com/grafana/xk6-sql",
+			Duplicates: []string{"github.com/example/xk6-sql/v2"},
+			Reasons:    []string{"same repository name xk6-sql"},
+		},
+	}, findDuplicates(catalog))
+
+	require.Empty(t, findDuplicates(map[string]*extension{"xk6-sql": catalog["xk6-sql"]}))
+}
+
+func TestOutputDuplicates(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputDuplicates(ts.GlobalState, []*duplicateGroup{{
+		Canonical:  "github.com/grafana/xk6-faker",
+		Duplicates: []string{"github.com/example/xk6-faker"},
+		Reasons:    []string{"same import k6/x/faker"},
+	}}))
+	require.Equal(t, `github.com/grafana/xk6-faker (canonical)
+  github.com/example/xk6-faker
+  reasons: same import k6/x/faker
+
+`, ts.Stdout.String())
+
+	ts = cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputDuplicates(ts.GlobalState, nil))
+	require.Equal(t, "No suspected duplicates found.\n", ts.Stdout.String())
+}
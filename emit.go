@@ -0,0 +1,170 @@
+package explore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+// Extension is an extension of the catalog as passed to the emit targets.
+type Extension struct {
+	// Module is the Go module path of the extension.
+	Module string
+	// Version is the latest version of the extension, empty if it has no release.
+	Version string
+	// Tier is the normalized tier, e.g. official or community.
+	Tier string
+	// Imports are the JavaScript import paths of the extension.
+	Imports []string
+	// Outputs are the output names of the extension.
+	Outputs []string
+	// Subcommands are the subcommand names of the extension.
+	Subcommands []string
+}
+
+// Emitter is an emit target of the --emit flag. It turns the listed extensions into
+// build inputs, e.g. commands, files or calls to an internal build service.
+type Emitter interface {
+	// Emit emits the extensions. Output meant for the user is written to w.
+	Emit(ctx context.Context, w io.Writer, extensions []Extension) error
+}
+
+// EmitterFunc adapts a function to the Emitter interface.
+type EmitterFunc func(ctx context.Context, w io.Writer, extensions []Extension) error
+
+// Emit calls f.
+func (f EmitterFunc) Emit(ctx context.Context, w io.Writer, extensions []Extension) error {
+	return f(ctx, w, extensions)
+}
+
+//nolint:gochecknoglobals
+var (
+	emittersMu sync.RWMutex
+	emitters   = map[string]Emitter{
+		string(emitGoGetTarget): EmitterFunc(emitGoGet),
+		string(emitCloudTarget): EmitterFunc(emitCloud),
+	}
+)
+
+// RegisterEmitter registers an emit target, making it available as --emit name.
+// It is meant to be called from the init function of another k6 extension built into
+// the same binary, in the same way as k6 extensions register themselves.
+// It panics if the name is empty or already registered.
+func RegisterEmitter(name string, emitter Emitter) {
+	emittersMu.Lock()
+	defer emittersMu.Unlock()
+
+	if name == "" {
+		panic("explore: emitter name is empty")
+	}
+
+	if _, found := emitters[name]; found {
+		panic(fmt.Sprintf("explore: emitter %q already registered", name))
+	}
+
+	emitters[name] = emitter
+}
+
+// lookupEmitter returns the emitter registered with the name.
+func lookupEmitter(name string) (Emitter, bool) {
+	emittersMu.RLock()
+	defer emittersMu.RUnlock()
+
+	emitter, found := emitters[name]
+
+	return emitter, found
+}
+
+// emitterNames returns the sorted names of the registered emit targets.
+func emitterNames() []string {
+	emittersMu.RLock()
+	defer emittersMu.RUnlock()
+
+	names := make([]string, 0, len(emitters))
+	for name := range emitters {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// emit runs the emit target on the extensions.
+func emit(gs *state.GlobalState, target emitTarget, extensions []*extension) error {
+	emitter, found := lookupEmitter(string(target))
+	if !found {
+		return fmt.Errorf("%w: %s", errInvalidEmit, target)
+	}
+
+	list := make([]Extension, 0, len(extensions))
+	for _, ext := range extensions {
+		list = append(list, Extension{
+			Module:      ext.Module,
+			Version:     ext.Latest,
+			Tier:        normalizedTier(ext),
+			Imports:     ext.Imports,
+			Outputs:     ext.Outputs,
+			Subcommands: ext.Subcommands,
+		})
+	}
+
+	return emitter.Emit(gs.Ctx, gs.Stdout, list)
+}
+
+// emitGoGet prints the commands adding the extensions to a k6 build managed with a
+// go.mod, for users building k6 manually instead of using xk6.
+func emitGoGet(_ context.Context, w io.Writer, extensions []Extension) error {
+	_, _ = fmt.Fprintln(w, "# Add the extensions to the go.mod of the k6 build:")
+
+	for _, ext := range extensions {
+		_, _ = fmt.Fprintf(w, "go get %s\n", moduleVersion(ext))
+	}
+
+	_, _ = fmt.Fprintln(w, "# Import the extensions in the main package of the k6 build:")
+
+	for _, ext := range extensions {
+		_, _ = fmt.Fprintf(w, "#   _ %q\n", ext.Module)
+	}
+
+	return nil
+}
+
+// emitCloud prints the dependencies of the extensions in the format of the k6 dependency
+// manifest, accepted by Grafana Cloud k6 test provisioning: a JSON object mapping the
+// JavaScript import paths and the output names to version constraints pinning the latest
+// version. Subcommands are left out, they are not used by cloud tests.
+func emitCloud(_ context.Context, w io.Writer, extensions []Extension) error {
+	deps := make(map[string]string)
+
+	for _, ext := range extensions {
+		constraint := "*"
+		if ext.Version != "" {
+			constraint = "=" + ext.Version
+		}
+
+		for _, name := range slices.Concat(ext.Imports, ext.Outputs) {
+			deps[name] = constraint
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(deps)
+}
+
+// moduleVersion returns the module@version query of the extension's latest version.
+func moduleVersion(ext Extension) string {
+	version := ext.Version
+	if version == "" {
+		version = "latest"
+	}
+
+	return ext.Module + "@" + version
+}
@@ -0,0 +1,73 @@
+package explore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestEmitGoGet(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4"},
+		{Module: "github.com/grafana/xk6-unreleased"},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, emit(ts.GlobalState, emitGoGetTarget, extensions))
+	require.Equal(t, `# Add the extensions to the go.mod of the k6 build:
+go get github.com/grafana/xk6-faker@v0.4.4
+go get github.com/grafana/xk6-unreleased@latest
+# Import the extensions in the main package of the k6 build:
+#   _ "github.com/grafana/xk6-faker"
+#   _ "github.com/grafana/xk6-unreleased"
+`, ts.Stdout.String())
+}
+
+func TestEmitCloud(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4", Imports: []string{"k6/x/faker"}},
+		{Module: "github.com/grafana/xk6-output-kafka", Outputs: []string{"xk6-kafka"}},
+		{Module: "github.com/grafana/xk6-dashboard", Latest: "v0.7.5", Subcommands: []string{"dashboard"}},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, emit(ts.GlobalState, emitCloudTarget, extensions))
+	require.JSONEq(t, `{"k6/x/faker": "=v0.4.4", "xk6-kafka": "*"}`, ts.Stdout.String())
+}
+
+func TestRegisterEmitter(t *testing.T) {
+	t.Parallel()
+
+	RegisterEmitter("test-build-service", EmitterFunc(func(_ context.Context, w io.Writer, extensions []Extension) error {
+		for _, ext := range extensions {
+			_, _ = fmt.Fprintf(w, "%s %s %s\n", ext.Module, ext.Version, ext.Tier)
+		}
+
+		return nil
+	}))
+
+	require.Contains(t, emitterNames(), "test-build-service")
+	require.Panics(t, func() { RegisterEmitter("test-build-service", EmitterFunc(emitGoGet)) })
+	require.Panics(t, func() { RegisterEmitter("", EmitterFunc(emitGoGet)) })
+
+	var target emitTarget
+
+	require.NoError(t, target.Set("test-build-service"))
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, emit(ts.GlobalState, target, []*extension{
+		{Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4", Tier: "official"},
+	}))
+	require.Equal(t, "github.com/grafana/xk6-faker v0.4.4 official\n", ts.Stdout.String())
+}
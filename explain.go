@@ -0,0 +1,222 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+var (
+	errInvalidK6Version = errors.New("invalid k6 version")
+	errNoK6Version      = errors.New("unable to detect the k6 version, give it as argument")
+)
+
+const (
+	explainHelpShort = "Explain whether a k6 version satisfies a version constraint"
+	explainHelpLong  = `Explain whether a k6 version satisfies the k6 version constraint of an extension.
+
+The constraint can be given as is, e.g. ">=v1.0.0, <v2.0.0", or as the name,
+module path or import path of an extension, whose constraint is then used.
+The k6 version defaults to the running k6.
+
+A constraint is satisfied if any of its alternatives separated by || is, and
+an alternative is satisfied if all of its comparisons are. For each alternative,
+the comparisons which fail are explained. Prerelease k6 versions are compared
+as their release, like Automatic Resolution does.
+`
+	explainHelpExample = `
+# Explain the constraint of an extension for the running k6:
+k6 x explore explain-constraint xk6-faker
+
+# Explain a constraint for a given k6 version:
+k6 x explore explain-constraint ">=v1.0.0, <v2.0.0" v2.1.0
+
+# Output as JSON:
+k6 x explore explain-constraint xk6-faker v1.2.0 --json
+`
+)
+
+// constraintExplanation explains whether a k6 version satisfies a version constraint.
+type constraintExplanation struct {
+	// Extension is the module path of the extension whose constraint is explained, if any.
+	Extension  string `json:"extension,omitempty"`
+	Constraint string `json:"constraint"`
+	Version    string `json:"version"`
+	// Compared is the version actually compared, the release of a prerelease version.
+	Compared     string                  `json:"compared"`
+	Satisfied    bool                    `json:"satisfied"`
+	Alternatives []constraintAlternative `json:"alternatives"`
+}
+
+// constraintAlternative is one of the alternatives of a constraint separated by ||.
+type constraintAlternative struct {
+	Constraint string `json:"constraint"`
+	Satisfied  bool   `json:"satisfied"`
+	// Reasons explain the failing comparisons.
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// newExplainCommand creates the "explain-constraint" subcommand of explore.
+func newExplainCommand(opts *options) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "explain-constraint <constraint|name> [k6-version]",
+		Short:   explainHelpShort,
+		Long:    explainHelpLong,
+		Example: explainHelpExample,
+		Args:    cobra.RangeArgs(1, 2), //nolint:mnd
+		RunE: func(_ *cobra.Command, args []string) error {
+			version := detectK6Version(opts.gs.Env, debug.ReadBuildInfo)
+			if len(args) > 1 {
+				version = args[1]
+			}
+
+			if version == "" {
+				return errNoK6Version
+			}
+
+			constraint, module := args[0], ""
+
+			if !isConstraint(constraint) {
+				_, ext, err := loadExtension(*opts, args[0])
+				if err != nil {
+					return err
+				}
+
+				constraint, module = ext.Constraints, ext.Module
+			}
+
+			explanation, err := explainConstraint(constraint, version)
+			if err != nil {
+				return err
+			}
+
+			explanation.Extension = module
+
+			if asJSON {
+				return outputJSON(opts.gs, explanation)
+			}
+
+			return outputExplanation(opts.gs, explanation)
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "output in JSON format")
+
+	return cmd
+}
+
+// isConstraint reports whether the argument is meant as a constraint rather than as an
+// extension reference: it parses as a constraint or contains a comparison operator.
+func isConstraint(arg string) bool {
+	if _, err := semver.NewConstraint(arg); err == nil {
+		return true
+	}
+
+	return strings.ContainsAny(arg, "<>=~^|")
+}
+
+// explainConstraint explains whether the k6 version satisfies the constraint.
+// An empty constraint is satisfied by any version.
+func explainConstraint(constraint string, version string) (*constraintExplanation, error) {
+	parsed, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", errInvalidK6Version, version, err)
+	}
+
+	release, err := parsed.SetPrerelease("")
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", errInvalidK6Version, version, err)
+	}
+
+	explanation := &constraintExplanation{
+		Constraint:   constraint,
+		Version:      version,
+		Compared:     release.String(),
+		Satisfied:    strings.TrimSpace(constraint) == "",
+		Alternatives: []constraintAlternative{},
+	}
+
+	if explanation.Satisfied {
+		return explanation, nil
+	}
+
+	for alternative := range strings.SplitSeq(constraint, "||") {
+		alternative = strings.TrimSpace(alternative)
+
+		parsed, err := semver.NewConstraint(alternative)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", errInvalidConstraint, constraint, err)
+		}
+
+		satisfied, errs := parsed.Validate(&release)
+
+		explained := constraintAlternative{Constraint: alternative, Satisfied: satisfied}
+		for _, err := range errs {
+			explained.Reasons = append(explained.Reasons, err.Error())
+		}
+
+		explanation.Satisfied = explanation.Satisfied || satisfied
+		explanation.Alternatives = append(explanation.Alternatives, explained)
+	}
+
+	return explanation, nil
+}
+
+func outputExplanation(gs *state.GlobalState, explanation *constraintExplanation) error {
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	result := "not satisfied"
+	if explanation.Satisfied {
+		result = "satisfied"
+	}
+
+	version := explanation.Version
+	if explanation.Compared != strings.TrimPrefix(version, "v") {
+		version += " (compared as " + explanation.Compared + ")"
+	}
+
+	rows := [][2]string{{"Constraint", valueOrNone(explanation.Constraint)}}
+
+	if explanation.Extension != "" {
+		rows = [][2]string{{"Extension", explanation.Extension}, rows[0]}
+	}
+
+	rows = append(rows, [2]string{"k6 version", version}, [2]string{"Result", result})
+
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", row[0], row[1])
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(explanation.Alternatives) == 0 {
+		_, _ = fmt.Fprintln(gs.Stdout, "\nWithout constraint, any k6 version is accepted.")
+
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(gs.Stdout)
+
+	w = tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	for _, alternative := range explanation.Alternatives {
+		status := "satisfied"
+		if !alternative.Satisfied {
+			status = "not satisfied: " + strings.Join(alternative.Reasons, "; ")
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", alternative.Constraint, status)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,78 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestExplainConstraint(t *testing.T) {
+	t.Parallel()
+
+	explanation, err := explainConstraint(">=v1.0.0, <v2.0.0 || ~v0.5", "v2.1.0-rc1")
+	require.NoError(t, err)
+	require.Equal(t, &constraintExplanation{
+		Constraint: ">=v1.0.0, <v2.0.0 || ~v0.5",
+		Version:    "v2.1.0-rc1",
+		Compared:   "2.1.0",
+		Satisfied:  false,
+		Alternatives: []constraintAlternative{
+			{Constraint: ">=v1.0.0, <v2.0.0", Reasons: []string{`"2.1.0" is greater than or equal to "v2.0.0"`}},
+			{Constraint: "~v0.5", Reasons: []string{`"2.1.0" does not have same major version as "v0.5"`}},
+		},
+	}, explanation)
+
+	explanation, err = explainConstraint(">=v1.0.0, <v2.0.0 || ~v0.5", "v1.2.0")
+	require.NoError(t, err)
+	require.True(t, explanation.Satisfied)
+	require.True(t, explanation.Alternatives[0].Satisfied)
+	require.False(t, explanation.Alternatives[1].Satisfied)
+
+	explanation, err = explainConstraint("", "v1.2.0")
+	require.NoError(t, err)
+	require.True(t, explanation.Satisfied)
+	require.Empty(t, explanation.Alternatives)
+
+	_, err = explainConstraint(">=v1.0.0", "latest")
+	require.ErrorIs(t, err, errInvalidK6Version)
+
+	_, err = explainConstraint(">=v1.0.0 || >>1", "v1.0.0")
+	require.ErrorIs(t, err, errInvalidConstraint)
+}
+
+func TestIsConstraint(t *testing.T) {
+	t.Parallel()
+
+	for arg, want := range map[string]bool{
+		">=v1.0.0, <v2.0.0":            true,
+		"~v0.5":                        true,
+		">>1":                          true,
+		"xk6-faker":                    false,
+		"k6/x/faker":                   false,
+		"github.com/grafana/xk6-faker": false,
+	} {
+		require.Equal(t, want, isConstraint(arg), arg)
+	}
+}
+
+func TestOutputExplanation(t *testing.T) {
+	t.Parallel()
+
+	explanation, err := explainConstraint(">=v1.0.0, <v2.0.0 || ~v0.5", "v2.1.0-rc1")
+	require.NoError(t, err)
+
+	explanation.Extension = "github.com/grafana/xk6-faker"
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputExplanation(ts.GlobalState, explanation))
+	require.Equal(t, `Extension:   github.com/grafana/xk6-faker
+Constraint:  >=v1.0.0, <v2.0.0 || ~v0.5
+k6 version:  v2.1.0-rc1 (compared as 2.1.0)
+Result:      not satisfied
+
+>=v1.0.0, <v2.0.0  not satisfied: "2.1.0" is greater than or equal to "v2.0.0"
+~v0.5              not satisfied: "2.1.0" does not have same major version as "v0.5"
+`, ts.Stdout.String())
+}
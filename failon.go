@@ -0,0 +1,204 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	errInvalidFailOn = errors.New("invalid --fail-on condition: allowed values are deprecated, stale>DURATION")
+	errNoAdvisories  = errors.New("the registry catalog publishes no security advisories to check")
+	errFailOn        = errors.New("extensions match the --fail-on conditions")
+	errFailEmpty     = errors.New("no extension matches the filters (--fail-empty)")
+)
+
+const (
+	conditionDeprecated = "deprecated"
+	conditionStale      = "stale"
+	// conditionAdvisories is rejected with an explanation, rather than as an unknown condition,
+	// until the registry publishes advisories: passing it would never fail a pipeline.
+	conditionAdvisories = "advisories"
+
+	hoursPerDay = 24
+	daysPerWeek = 7
+	// Months and years are calendar approximations, precise enough for staleness checks.
+	daysPerMonth = 30
+	daysPerYear  = 365
+)
+
+// ageRe matches an age in days, weeks, months or years, e.g. 12mo.
+var ageRe = regexp.MustCompile(`^([1-9][0-9]*)(d|w|mo|y)$`)
+
+// parseAge parses an age like 90d, 2w, 12mo or 1y.
+func parseAge(s string) (time.Duration, bool) {
+	m := ageRe.FindStringSubmatch(s)
+	if m == nil {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+
+	days := map[string]int{"d": 1, "w": daysPerWeek, "mo": daysPerMonth, "y": daysPerYear}[m[2]]
+
+	return time.Duration(n*days*hoursPerDay) * time.Hour, true
+}
+
+// failCondition is a policy condition of the --fail-on flag.
+type failCondition struct {
+	// spec is the condition as given on the command line.
+	spec string
+	name string
+	// age is the threshold of the stale condition.
+	age time.Duration
+}
+
+// failOn is the list of conditions given by the --fail-on flag.
+type failOn []failCondition
+
+func (f *failOn) String() string {
+	if f == nil {
+		return ""
+	}
+
+	names := make([]string, 0, len(*f))
+	for _, cond := range *f {
+		names = append(names, cond.String())
+	}
+
+	return strings.Join(names, ",")
+}
+
+// Set parses a comma-separated list of conditions. Repeated flags accumulate.
+func (f *failOn) Set(s string) error {
+	for value := range strings.SplitSeq(s, ",") {
+		cond, err := parseFailCondition(strings.TrimSpace(value))
+		if err != nil {
+			return err
+		}
+
+		*f = append(*f, cond)
+	}
+
+	return nil
+}
+
+func (f *failOn) Type() string {
+	return "conditions"
+}
+
+func parseFailCondition(s string) (failCondition, error) {
+	switch s {
+	case conditionDeprecated:
+		return failCondition{spec: s, name: conditionDeprecated}, nil
+	case conditionAdvisories:
+		return failCondition{}, fmt.Errorf("%w: %q, %w", errInvalidFailOn, s, errNoAdvisories)
+	}
+
+	age, found := strings.CutPrefix(s, conditionStale+">")
+	if !found {
+		return failCondition{}, fmt.Errorf("%w: %q", errInvalidFailOn, s)
+	}
+
+	d, ok := parseAge(age)
+	if !ok {
+		return failCondition{}, fmt.Errorf("%w: %q", errInvalidFailOn, s)
+	}
+
+	return failCondition{spec: s, name: conditionStale, age: d}, nil
+}
+
+func (c failCondition) String() string {
+	return c.spec
+}
+
+// match reports whether the extension matches the condition, with a human readable reason.
+// The extensions with a deprecation timeline or an archived repository count as deprecated
+// as well, like with isDeprecated.
+// Extensions without a repository timestamp never match the stale condition.
+// The update date of the reason is given in the time zone of now.
+func (c failCondition) match(ext *extension, now time.Time) (string, bool) {
+	switch c.name {
+	case conditionDeprecated:
+		return deprecationReason(ext), isDeprecated(ext)
+	case conditionStale:
+		if ext.Repo == nil || ext.Repo.Timestamp == 0 {
+			return "", false
+		}
+
+		updated := time.Unix(int64(ext.Repo.Timestamp), 0)
+
+		return "last updated " + updated.In(now.Location()).Format(time.DateOnly), now.Sub(updated) > c.age
+	default:
+		return "", false
+	}
+}
+
+// deprecationReason tells why the extension counts as deprecated, empty if it does not.
+func deprecationReason(ext *extension) string {
+	switch {
+	case ext.Deprecated:
+		return "marked as deprecated in the registry"
+	case ext.Deprecation != nil:
+		reason := "deprecation scheduled"
+
+		if ext.Deprecation.Removal != "" {
+			reason += ", removal on " + ext.Deprecation.Removal
+		}
+
+		if ext.Deprecation.Replacement != "" {
+			reason += ", replaced by " + ext.Deprecation.Replacement
+		}
+
+		return reason
+	case ext.Repo != nil && ext.Repo.Archived:
+		return "repository is archived"
+	default:
+		return ""
+	}
+}
+
+// has reports whether the condition is among the conditions.
+func (f failOn) has(name string) bool {
+	for _, cond := range f {
+		if cond.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// check returns errFailOn listing the extensions which match any of the conditions.
+func (f failOn) check(extensions []*extension, now time.Time) error {
+	var matches []string
+
+	for _, ext := range extensions {
+		for _, cond := range f {
+			if reason, matched := cond.match(ext, now); matched {
+				matches = append(matches, fmt.Sprintf("  %s: %s (%s)", ext.Module, cond, reason))
+			}
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w:\n%s", errFailOn, strings.Join(matches, "\n"))
+}
+
+// checkResults applies the policy checks to the listed extensions: --fail-empty, then --fail-on.
+func checkResults(opts options, extensions []*extension, now time.Time) error {
+	if opts.failEmpty && len(extensions) == 0 {
+		return errFailEmpty
+	}
+
+	return opts.failOn.check(extensions, now)
+}
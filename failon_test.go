@@ -0,0 +1,112 @@
+package explore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailOnSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "deprecated", value: "deprecated", want: "deprecated"},
+		{name: "stale months", value: "stale>12mo", want: "stale>12mo"},
+		{name: "list", value: "deprecated, stale>2w", want: "deprecated,stale>2w"},
+		{name: "stale years", value: "stale>1y", want: "stale>1y"},
+		{name: "stale without age", value: "stale", wantErr: true},
+		{name: "zero age", value: "stale>0d", wantErr: true},
+		{name: "unknown unit", value: "stale>3h", wantErr: true},
+		{name: "advisories", value: "deprecated,advisories", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var f failOn
+
+			err := f.Set(tt.value)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errInvalidFailOn)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, f.String())
+		})
+	}
+
+	var f failOn
+
+	// The registry publishes no advisories, the error tells so.
+	require.ErrorIs(t, f.Set("advisories"), errNoAdvisories)
+}
+
+func TestFailOnCheck(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	extensions := []*extension{
+		{
+			Module: "github.com/grafana/xk6-fresh",
+			Repo:   &repository{Timestamp: float64(now.AddDate(0, -1, 0).Unix())},
+		},
+		{
+			Module: "github.com/grafana/xk6-stale",
+			Repo:   &repository{Timestamp: float64(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC).Unix())},
+		},
+		{
+			Module: "github.com/grafana/xk6-archived",
+			Repo:   &repository{Archived: true},
+		},
+		{Module: "github.com/grafana/xk6-norepo"},
+		{Module: "github.com/grafana/xk6-deprecated", Deprecated: true},
+		{
+			Module:      "github.com/grafana/xk6-scheduled",
+			Deprecation: &deprecation{Since: "2025-01-15", Removal: "2025-12-31", Replacement: "go.k6.io/k6/v2"},
+		},
+		{Module: "github.com/grafana/xk6-announced", Deprecation: &deprecation{Since: "2025-01-15"}},
+	}
+
+	var f failOn
+
+	require.NoError(t, f.Set("deprecated,stale>12mo"))
+
+	err := f.check(extensions, now)
+	require.ErrorIs(t, err, errFailOn)
+	require.Equal(t, `extensions match the --fail-on conditions:
+  github.com/grafana/xk6-stale: stale>12mo (last updated 2023-01-02)
+  github.com/grafana/xk6-archived: deprecated (repository is archived)
+  github.com/grafana/xk6-deprecated: deprecated (marked as deprecated in the registry)
+  github.com/grafana/xk6-scheduled: deprecated (deprecation scheduled, removal on 2025-12-31, replaced by go.k6.io/k6/v2)
+  github.com/grafana/xk6-announced: deprecated (deprecation scheduled)`, err.Error())
+	require.True(t, f.has(conditionDeprecated))
+	require.False(t, failOn(nil).has(conditionDeprecated))
+
+	require.NoError(t, f.check(extensions[:1], now))
+	require.NoError(t, failOn(nil).check(extensions, now))
+}
+
+func TestCheckResults(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	extensions := []*extension{{Module: "github.com/grafana/xk6-sql"}}
+
+	require.NoError(t, checkResults(options{}, nil, now))
+	require.ErrorIs(t, checkResults(options{failEmpty: true}, nil, now), errFailEmpty)
+	require.NoError(t, checkResults(options{failEmpty: true}, extensions, now))
+
+	opts := options{failEmpty: true, failOn: failOn{{spec: conditionDeprecated, name: conditionDeprecated}}}
+
+	require.ErrorIs(t, checkResults(opts, []*extension{{Module: "github.com/grafana/xk6-old", Deprecated: true}}, now), errFailOn)
+}
@@ -0,0 +1,139 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+)
+
+var errInvalidFilter = errors.New("invalid --filter expression")
+
+// exprFilter is the CEL expression given by the --filter flag, evaluated for each extension,
+// e.g. tier == "official" && size(imports) > 0 && stars > 100. The variables are listed in
+// filterVariables.
+type exprFilter struct {
+	spec    string
+	program cel.Program
+}
+
+// filterVariables declares the variables of the --filter expressions.
+//
+//nolint:gochecknoglobals
+var filterVariables = []cel.EnvOption{
+	cel.Variable("module", cel.StringType),
+	cel.Variable("tier", cel.StringType),
+	cel.Variable("description", cel.StringType),
+	cel.Variable("latest", cel.StringType),
+	cel.Variable("versions", cel.ListType(cel.StringType)),
+	cel.Variable("kinds", cel.ListType(cel.StringType)),
+	cel.Variable("imports", cel.ListType(cel.StringType)),
+	cel.Variable("outputs", cel.ListType(cel.StringType)),
+	cel.Variable("subcommands", cel.ListType(cel.StringType)),
+	cel.Variable("categories", cel.ListType(cel.StringType)),
+	cel.Variable("products", cel.ListType(cel.StringType)),
+	cel.Variable("constraints", cel.StringType),
+	cel.Variable("owner", cel.StringType),
+	cel.Variable("stars", cel.IntType),
+	cel.Variable("license", cel.StringType),
+	cel.Variable("deprecated", cel.BoolType),
+	cel.Variable("cgo", cel.BoolType),
+}
+
+func (f *exprFilter) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return f.spec
+}
+
+// Set compiles the expression, which must evaluate to a boolean.
+func (f *exprFilter) Set(s string) error {
+	env, err := cel.NewEnv(filterVariables...)
+	if err != nil {
+		return err
+	}
+
+	ast, issues := env.Compile(s)
+	if issues != nil && issues.Err() != nil {
+		return fmt.Errorf("%w: %w", errInvalidFilter, issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType {
+		return fmt.Errorf("%w: the result is %s instead of bool", errInvalidFilter, ast.OutputType())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidFilter, err)
+	}
+
+	*f = exprFilter{spec: s, program: program}
+
+	return nil
+}
+
+func (f *exprFilter) Type() string {
+	return "expression"
+}
+
+// filter matches the extensions for which the expression is true.
+// Extensions for which the evaluation fails, e.g. on a division by zero, don't match.
+func (f *exprFilter) filter(ext *extension) bool {
+	if f == nil || f.program == nil {
+		return true
+	}
+
+	out, _, err := f.program.Eval(filterActivation(ext))
+	if err != nil {
+		return false
+	}
+
+	matched, ok := out.Value().(bool)
+
+	return ok && matched
+}
+
+// filterActivation returns the values of the filter variables for the extension.
+// Missing values are empty rather than absent, so expressions don't need to check them.
+func filterActivation(ext *extension) map[string]any {
+	owner, stars, license := "", int64(0), ""
+
+	if ext.Repo != nil {
+		stars, license = int64(ext.Repo.Stars), ext.Repo.License
+	}
+
+	if owners := extensionOwners(ext); len(owners) > 0 {
+		owner = owners[0]
+	}
+
+	return map[string]any{
+		"module":      ext.Module,
+		"tier":        normalizedTier(ext),
+		"description": ext.Description,
+		"latest":      ext.Latest,
+		"versions":    nonNil(ext.Versions),
+		"kinds":       extensionKinds(ext),
+		"imports":     nonNil(ext.Imports),
+		"outputs":     nonNil(ext.Outputs),
+		"subcommands": nonNil(ext.Subcommands),
+		"categories":  nonNil(ext.Categories),
+		"products":    nonNil(ext.Products),
+		"constraints": ext.Constraints,
+		"owner":       owner,
+		"stars":       stars,
+		"license":     license,
+		"deprecated":  isDeprecated(ext),
+		"cgo":         ext.Cgo,
+	}
+}
+
+// nonNil returns an empty list instead of nil.
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+
+	return list
+}
@@ -0,0 +1,56 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExprFilter(t *testing.T) {
+	t.Parallel()
+
+	faker := &extension{
+		Module:  "github.com/grafana/xk6-faker",
+		Tier:    "Official",
+		Imports: []string{"k6/x/faker"},
+		Repo:    &repository{Owner: "grafana", Stars: 150, License: "AGPL-3.0"},
+	}
+	dashboard := &extension{Module: "github.com/grafana/xk6-dashboard", Subcommands: []string{"dashboard"}}
+
+	tests := []struct {
+		name string
+		expr string
+		want []bool
+	}{
+		{
+			name: "tier, imports and stars",
+			expr: `tier == "official" && size(imports) > 0 && stars > 100`,
+			want: []bool{true, false},
+		},
+		{name: "missing repository", expr: `stars == 0 && license == ""`, want: []bool{false, true}},
+		{name: "owner from module path", expr: `owner == "grafana"`, want: []bool{true, true}},
+		{name: "kinds", expr: `"subcommand" in kinds`, want: []bool{false, true}},
+		{name: "string functions", expr: `module.endsWith("-faker")`, want: []bool{true, false}},
+		{name: "evaluation error", expr: `stars / 0 > 1`, want: []bool{false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var f exprFilter
+
+			require.NoError(t, f.Set(tt.expr))
+			require.Equal(t, tt.expr, f.String())
+			require.Equal(t, tt.want, []bool{f.filter(faker), f.filter(dashboard)})
+		})
+	}
+
+	var unset exprFilter
+
+	require.True(t, unset.filter(faker))
+
+	for _, invalid := range []string{`tier ==`, `unknown > 1`, `stars + 1`} {
+		require.ErrorIs(t, unset.Set(invalid), errInvalidFilter, invalid)
+	}
+}
@@ -0,0 +1,122 @@
+package explore
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+// goldenRenderings are the outputs written by the hidden --render-golden flag, by file name.
+// They are the reference outputs of the fixture catalog: a formatter change shows up as a
+// readable diff of the golden files, and downstream parsers can be tested against them.
+//
+//nolint:gochecknoglobals
+var goldenRenderings = map[string]options{
+	"table.txt":     {},
+	"wide.txt":      {format: formatWide},
+	"detailed.txt":  {detailed: true},
+	"plain.txt":     {plain: true},
+	"porcelain.txt": {porcelain: porcelainV1},
+	"json.json":     {format: formatJSON},
+	"ndjson.ndjson": {format: formatNDJSON},
+	"csv.csv":       {format: formatCSV},
+	"tsv.tsv":       {format: formatTSV},
+	"html.html":     {format: formatHTML},
+	"template.txt":  {format: format(templatePrefix + "{{.Module}}@{{.Latest}} {{.Tier}} {{join .Kinds \",\"}}")},
+}
+
+// goldenCatalog returns the fixture catalog of the golden files.
+// It covers each extension type and tier, a deprecated extension and the optional fields.
+func goldenCatalog() []*extension {
+	updated := float64(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC).Unix())
+
+	return []*extension{
+		{
+			Module:      "github.com/grafana/xk6-faker",
+			Tier:        "official",
+			Description: "Generate fake data in your tests",
+			Latest:      "v0.4.4",
+			Versions:    []string{"v0.4.4", "v0.4.3", "v0.4.2"},
+			Imports:     []string{"k6/x/faker"},
+			Categories:  []string{"data"},
+			Constraints: ">=v1.0.0",
+			Repo: &repository{
+				URL: "https://github.com/grafana/xk6-faker", Owner: "grafana",
+				Stars: 12345, License: "AGPL-3.0", Timestamp: updated,
+			},
+		},
+		{
+			Module:      "github.com/grafana/xk6-dashboard",
+			Tier:        "official",
+			Description: "A k6 extension that makes k6 metrics available on a web-based dashboard, \"live\", while the test runs",
+			Latest:      "v0.7.5",
+			Versions:    []string{"v0.7.5", "v0.7.4"},
+			Outputs:     []string{"dashboard"},
+			Repo:        &repository{URL: "https://github.com/grafana/xk6-dashboard", Owner: "grafana", Stars: 420},
+		},
+		{
+			Module:      "github.com/example/xk6-subcommand-httpbin",
+			Description: "Run a local httpbin server from k6",
+			Latest:      "v1.0.0",
+			Versions:    []string{"v1.0.0"},
+			Subcommands: []string{"httpbin"},
+			Cgo:         true,
+			Platforms:   []string{"linux/amd64", "linux/arm64"},
+			Repo:        &repository{URL: "https://github.com/example/xk6-subcommand-httpbin", License: "MIT"},
+		},
+		{
+			Module:      "github.com/example/xk6-legacy",
+			Description: "Legacy, replaced by the built-in module",
+			Latest:      "v0.1.0",
+			Versions:    []string{"v0.1.0"},
+			Imports:     []string{"k6/x/legacy"},
+			Deprecation: &deprecation{Since: "2025-01-15", Removal: "2025-12-31", Replacement: "go.k6.io/k6/v2"},
+			Notes:       "Migrate before the end of the year",
+		},
+	}
+}
+
+// renderGolden writes the golden files of the fixture catalog into the directory.
+// The outputs are rendered as if not on a terminal, so they are the same everywhere.
+func renderGolden(gs *state.GlobalState, dir string) error {
+	if err := gs.FS.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+
+	fetchedAt := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	extensions := goldenCatalog()
+	sortExtensions(extensions, nil)
+
+	for _, name := range slices.Sorted(maps.Keys(goldenRenderings)) {
+		var buf bytes.Buffer
+
+		stdout := *gs.Stdout
+		stdout.Writer, stdout.IsTTY = &buf, false
+
+		golden := *gs
+		golden.Stdout = &stdout
+		golden.Flags.Quiet, golden.Flags.NoColor = false, true
+
+		opts := goldenRenderings[name]
+		opts.gs = &golden
+
+		if err := output(opts, extensions, fetchedAt); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if err := fsext.WriteFile(gs.FS, filepath.Join(dir, name), buf.Bytes(), 0o600); err != nil {
+			return err
+		}
+	}
+
+	gs.Logger.Infof("Golden files written to %s", dir)
+
+	return nil
+}
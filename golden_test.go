@@ -0,0 +1,44 @@
+package explore
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+// updateGolden rewrites the golden files, e.g. after an intended formatter change:
+// go test -run TestGolden -update-golden.
+//
+//nolint:gochecknoglobals
+var updateGolden = flag.Bool("update-golden", false, "rewrite the golden files in testdata/golden")
+
+func TestGolden(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, renderGolden(ts.GlobalState, "golden"))
+
+	for name := range goldenRenderings {
+		got, err := fsext.ReadFile(ts.FS, filepath.Join("golden", name))
+		require.NoError(t, err)
+
+		path := filepath.Join("testdata", "golden", name)
+
+		if *updateGolden {
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+			require.NoError(t, os.WriteFile(path, got, 0o600))
+
+			continue
+		}
+
+		want, err := os.ReadFile(path) //nolint:gosec
+		require.NoError(t, err, "run go test -run TestGolden -update-golden to create the golden files")
+		require.Equal(t, string(want), string(got), name)
+	}
+}
@@ -0,0 +1,273 @@
+package explore
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+const harVersion = "1.2"
+
+// redactedHeaders are not written to the trace file, so it can be attached to bug reports.
+//
+//nolint:gochecknoglobals
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// harRecorder records the HTTP interactions of the clients it is attached to in the
+// HTTP Archive (HAR) format, see http://www.softwareishard.com/blog/har-12-spec/.
+type harRecorder struct {
+	mu      sync.Mutex
+	entries []*harEntry
+}
+
+// harTransport is the http.RoundTripper of a client attached to a recorder, recording
+// the requests sent through the transport it wraps.
+type harTransport struct {
+	recorder  *harRecorder
+	transport http.RoundTripper
+}
+
+type harLog struct {
+	Log struct {
+		Version string      `json:"version"`
+		Creator harCreator  `json:"creator"`
+		Entries []*harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime time.Time   `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	// Error is the transport error of a request without response.
+	Error string `json:"_error,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	Cookies     []harNameValue `json:"cookies"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	Cookies     []harNameValue `json:"cookies"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+func newHARRecorder() *harRecorder {
+	return new(harRecorder)
+}
+
+// RoundTrip performs the request and records it together with the response.
+// The response body is read completely, so it can be written to the trace.
+func (t *harTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	started := time.Now()
+
+	entry := &harEntry{
+		StartedDateTime: started,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: req.Proto,
+			Headers:     harHeaders(req.Header),
+			QueryString: make([]harNameValue, 0),
+			Cookies:     make([]harNameValue, 0),
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Response: harResponse{Headers: make([]harNameValue, 0), Cookies: make([]harNameValue, 0)},
+	}
+
+	for name, values := range req.URL.Query() {
+		for _, value := range values {
+			entry.Request.QueryString = append(entry.Request.QueryString, harNameValue{Name: name, Value: value})
+		}
+	}
+
+	defer t.recorder.record(entry, started)
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		entry.Error = err.Error()
+
+		return nil, err
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+
+	_ = resp.Body.Close()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	entry.Response = harResponse{
+		Status:      resp.StatusCode,
+		StatusText:  strings.TrimPrefix(resp.Status, strconv.Itoa(resp.StatusCode)+" "),
+		HTTPVersion: resp.Proto,
+		Headers:     harHeaders(resp.Header),
+		Cookies:     make([]harNameValue, 0),
+		Content: harContent{
+			Size:     len(body),
+			MimeType: resp.Header.Get("Content-Type"),
+			Text:     string(body),
+		},
+		RedirectURL: resp.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    len(body),
+	}
+
+	if readErr != nil {
+		entry.Error = readErr.Error()
+
+		return nil, readErr
+	}
+
+	return resp, nil
+}
+
+func (r *harRecorder) record(entry *harEntry, started time.Time) {
+	elapsed := float64(time.Since(started)) / float64(time.Millisecond)
+
+	entry.Time = elapsed
+	entry.Timings = harTimings{Send: 0, Wait: elapsed, Receive: 0}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+// write writes the recorded interactions to the trace file.
+func (r *harRecorder) write(gs *state.GlobalState, path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var har harLog
+
+	har.Log.Version = harVersion
+	har.Log.Creator = harCreator{Name: "xk6-subcommand-explore", Version: extensionVersion(debug.ReadBuildInfo)}
+	har.Log.Entries = r.entries
+
+	if har.Log.Entries == nil {
+		har.Log.Entries = make([]*harEntry, 0)
+	}
+
+	data, err := json.MarshalIndent(&har, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return fsext.WriteFile(gs.FS, path, data, 0o600)
+}
+
+func harHeaders(header http.Header) []harNameValue {
+	headers := make([]harNameValue, 0, len(header))
+
+	for name, values := range header {
+		for _, value := range values {
+			for _, redacted := range redactedHeaders {
+				if strings.EqualFold(name, redacted) {
+					value = "REDACTED"
+				}
+			}
+
+			headers = append(headers, harNameValue{Name: name, Value: value})
+		}
+	}
+
+	return headers
+}
+
+// attach routes the requests of the client through the recorder, if any, wrapping the
+// transport of the client.
+func (r *harRecorder) attach(client *http.Client) {
+	if r == nil {
+		return
+	}
+
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	client.Transport = &harTransport{recorder: r, transport: transport}
+}
+
+// addTrace records the HTTP interactions of the command and its subcommands when the
+// --trace-file flag is given. The trace is written even if the command fails,
+// as failed runs are the ones worth attaching to a bug report.
+func addTrace(cmd *cobra.Command, opts *options) {
+	if run := cmd.RunE; run != nil {
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			if opts.traceFile == "" {
+				return run(cmd, args)
+			}
+
+			opts.recorder = newHARRecorder()
+
+			err := run(cmd, args)
+
+			if traceErr := opts.recorder.write(opts.gs, opts.traceFile); traceErr != nil {
+				if err != nil {
+					opts.gs.Logger.Warnf("Failed to write trace file %s: %v", opts.traceFile, traceErr)
+
+					return err
+				}
+
+				return traceErr
+			}
+
+			return err
+		}
+	}
+
+	for _, sub := range cmd.Commands() {
+		addTrace(sub, opts)
+	}
+}
@@ -0,0 +1,102 @@
+package explore
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestHARRecorder(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = w.Write([]byte(`{"xk6-faker":{}}`))
+	}))
+	defer server.Close()
+
+	recorder := newHARRecorder()
+	client := new(http.Client)
+
+	recorder.attach(client)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/catalog.json?page=2", nil)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer secret")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.JSONEq(t, `{"xk6-faker":{}}`, string(body), "the response body must still be readable")
+
+	_, err = client.Get("http://localhost:0") //nolint:noctx
+	require.Error(t, err)
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, recorder.write(ts.GlobalState, "trace.har"))
+
+	data, err := fsext.ReadFile(ts.FS, "trace.har")
+	require.NoError(t, err)
+
+	var har harLog
+
+	require.NoError(t, json.Unmarshal(data, &har))
+	require.Equal(t, harVersion, har.Log.Version)
+	require.Equal(t, harCreator{Name: "xk6-subcommand-explore", Version: extensionVersion(debug.ReadBuildInfo)}, har.Log.Creator)
+	require.Len(t, har.Log.Entries, 2)
+
+	entry := har.Log.Entries[0]
+
+	require.Equal(t, http.MethodGet, entry.Request.Method)
+	require.Equal(t, []harNameValue{{Name: "page", Value: "2"}}, entry.Request.QueryString)
+	require.Contains(t, entry.Request.Headers, harNameValue{Name: "Authorization", Value: "REDACTED"})
+	require.Equal(t, http.StatusTeapot, entry.Response.Status)
+	require.Equal(t, "I'm a teapot", entry.Response.StatusText)
+	require.Equal(t, "application/json", entry.Response.Content.MimeType)
+	require.JSONEq(t, `{"xk6-faker":{}}`, entry.Response.Content.Text)
+
+	require.Zero(t, har.Log.Entries[1].Response.Status)
+	require.NotEmpty(t, har.Log.Entries[1].Error)
+}
+
+func TestAddTrace(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+	opts := &options{gs: ts.GlobalState, traceFile: "trace.har"}
+	errRun := errors.New("run failed")
+
+	root := &cobra.Command{Use: "root"}
+	sub := &cobra.Command{
+		Use: "sub",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			require.NotNil(t, opts.recorder)
+
+			return errRun
+		},
+	}
+
+	root.AddCommand(sub)
+	addTrace(root, opts)
+
+	require.ErrorIs(t, sub.RunE(sub, nil), errRun)
+
+	exists, err := fsext.Exists(ts.FS, "trace.har")
+	require.NoError(t, err)
+	require.True(t, exists, "the trace must be written for failed runs")
+}
@@ -0,0 +1,101 @@
+package explore
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+const (
+	// Reverse video is switched on and off explicitly, so highlighting does not reset
+	// the other attributes (bold, italic, ...) of the surrounding text.
+	highlightOn  = "\x1b[7m"
+	highlightOff = "\x1b[27m"
+)
+
+// highlighter marks the matches of the search terms and the --match pattern in a text.
+// A nil highlighter leaves the text unchanged.
+type highlighter []*regexp.Regexp
+
+// newHighlighter returns the highlighter of the search terms and the --match pattern,
+// or nil if there is nothing to highlight or the output is not a colored terminal.
+func newHighlighter(gs *state.GlobalState, terms []string, caseSensitive bool, match matchPattern) highlighter {
+	if gs.Flags.NoColor || !gs.Stdout.IsTTY {
+		return nil
+	}
+
+	var h highlighter
+
+	prefix := "(?i)"
+	if caseSensitive {
+		prefix = ""
+	}
+
+	for _, term := range terms {
+		if term != "" {
+			h = append(h, regexp.MustCompile(prefix+regexp.QuoteMeta(term)))
+		}
+	}
+
+	if match.re != nil {
+		h = append(h, match.re)
+	}
+
+	return h
+}
+
+// apply returns the text with the matches highlighted. Overlapping matches are merged.
+func (h highlighter) apply(s string) string {
+	if len(h) == 0 {
+		return s
+	}
+
+	var spans [][]int
+
+	for _, re := range h {
+		for _, loc := range re.FindAllStringIndex(s, -1) {
+			if loc[0] < loc[1] {
+				spans = append(spans, loc)
+			}
+		}
+	}
+
+	if len(spans) == 0 {
+		return s
+	}
+
+	slices.SortFunc(spans, func(a, b []int) int { return a[0] - b[0] })
+
+	merged := spans[:1]
+
+	for _, span := range spans[1:] {
+		last := merged[len(merged)-1]
+
+		if span[0] <= last[1] {
+			last[1] = max(last[1], span[1])
+
+			continue
+		}
+
+		merged = append(merged, span)
+	}
+
+	var buf strings.Builder
+
+	pos := 0
+
+	for _, span := range merged {
+		buf.WriteString(s[pos:span[0]])
+		buf.WriteString(highlightOn)
+		buf.WriteString(s[span[0]:span[1]])
+		buf.WriteString(highlightOff)
+
+		pos = span[1]
+	}
+
+	buf.WriteString(s[pos:])
+
+	return buf.String()
+}
@@ -0,0 +1,100 @@
+package explore
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestHighlighterApply(t *testing.T) {
+	t.Parallel()
+
+	var match matchPattern
+
+	require.NoError(t, match.Set(`k6/x/\w+`))
+
+	ts := cmdtests.NewGlobalTestState(t)
+	ts.GlobalState.Stdout.IsTTY = true
+
+	tests := []struct {
+		name          string
+		terms         []string
+		caseSensitive bool
+		match         matchPattern
+		input         string
+		want          string
+	}{
+		{
+			name:  "term",
+			terms: []string{"faker"},
+			input: "xk6-faker generates fake data",
+			want:  "xk6-" + highlightOn + "faker" + highlightOff + " generates fake data",
+		},
+		{
+			name:  "case-insensitive",
+			terms: []string{"FAKE"},
+			input: "Fake data",
+			want:  highlightOn + "Fake" + highlightOff + " data",
+		},
+		{
+			name:          "case-sensitive",
+			terms:         []string{"FAKE"},
+			caseSensitive: true,
+			input:         "Fake data",
+			want:          "Fake data",
+		},
+		{
+			name:  "overlapping",
+			terms: []string{"fake", "ker"},
+			match: match,
+			input: "faker via k6/x/faker",
+			want:  highlightOn + "faker" + highlightOff + " via " + highlightOn + "k6/x/faker" + highlightOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hl := newHighlighter(ts.GlobalState, tt.terms, tt.caseSensitive, tt.match)
+
+			require.Equal(t, tt.want, hl.apply(tt.input))
+		})
+	}
+}
+
+func TestNewHighlighterNoTTY(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.Nil(t, newHighlighter(ts.GlobalState, []string{"faker"}, false, matchPattern{}))
+
+	ts.GlobalState.Stdout.IsTTY = true
+	ts.Flags.NoColor = true
+
+	require.Nil(t, newHighlighter(ts.GlobalState, []string{"faker"}, false, matchPattern{}))
+}
+
+func TestOutputTableHighlight(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4", Description: "Generate fake data"},
+		{Module: "github.com/grafana/xk6-sql", Latest: "v1.0.0", Description: "Load-test SQL Servers"},
+	}
+
+	hl := highlighter{regexp.MustCompile("faker")}
+
+	require.NoError(t, outputTable(ts.GlobalState, extensions, tableLayout{columns: defaultColumns, notrunc: true}, hl))
+	require.Equal(t, `MODULE                        LATEST  TYPE  TIER  DESCRIPTION
+github.com/grafana/xk6-`+highlightOn+`faker`+highlightOff+`  v0.4.4        com   Generate fake data
+github.com/grafana/xk6-sql    v1.0.0        com   Load-test SQL Servers
+
+TIER  com: Community
+`, ts.Stdout.String())
+}
@@ -0,0 +1,85 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+var errNoHistory = errors.New("no snapshot contains the extension")
+
+const historyHeader = "DATE\tLATEST\tCONSTRAINTS\n"
+
+// historyEntry is the state of an extension in a catalog snapshot.
+type historyEntry struct {
+	Date        time.Time `json:"date"`
+	Latest      string    `json:"latest,omitempty"`
+	Constraints string    `json:"constraints,omitempty"`
+}
+
+// loadHistory returns the changes of the latest version and the k6 version constraints of
+// an extension across the catalog snapshots (see the export subcommand) found in dir,
+// oldest first. Files which are not valid snapshots are skipped.
+func loadHistory(gs *state.GlobalState, dir string, query string) ([]*historyEntry, error) {
+	files, err := fsext.ReadDir(gs.FS, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*historyEntry, 0)
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".tar") {
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+
+		catalog, metadata, err := readSnapshot(gs, path)
+		if err != nil {
+			gs.Logger.Debugf("Skipping %s: %v", path, err)
+
+			continue
+		}
+
+		if _, ext := findExtension(catalog, query); ext != nil {
+			entries = append(entries, &historyEntry{
+				Date:        metadata.CreatedAt,
+				Latest:      ext.Latest,
+				Constraints: ext.Constraints,
+			})
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: %s in %s", errNoHistory, query, dir)
+	}
+
+	slices.SortFunc(entries, func(a, b *historyEntry) int { return a.Date.Compare(b.Date) })
+
+	// Keep only the snapshots where something changed.
+	return slices.CompactFunc(entries, func(a, b *historyEntry) bool {
+		return a.Latest == b.Latest && a.Constraints == b.Constraints
+	}), nil
+}
+
+// outputHistory writes the snapshot dates in the given date format.
+func outputHistory(gs *state.GlobalState, entries []*historyEntry, dates dateFormat) error {
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	_, _ = w.Write([]byte(historyHeader))
+
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n",
+			dates.date(entry.Date), valueOrNone(entry.Latest), valueOrNone(entry.Constraints))
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,58 @@
+package explore
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestLoadHistory(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	snapshot := func(date time.Time, versions []string, constraints string) {
+		t.Helper()
+
+		catalog := map[string]*extension{
+			"xk6-faker": {Module: "github.com/grafana/xk6-faker", Versions: versions, Constraints: constraints},
+		}
+
+		path := filepath.Join("snapshots", date.Format(time.DateOnly)+".tar")
+
+		_, err := exportSnapshot(ts.GlobalState, catalog, "", path, date)
+		require.NoError(t, err)
+	}
+
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+
+	require.NoError(t, ts.FS.MkdirAll("snapshots", 0o750))
+
+	snapshot(day(3, 1), []string{"v0.3.0"}, ">=v0.50")
+	snapshot(day(1, 1), []string{"v0.2.0"}, "")
+	snapshot(day(2, 1), []string{"v0.2.0", "v0.3.0"}, ">=v0.50")
+	snapshot(day(4, 1), []string{"v0.3.0", "v0.4.0"}, ">=v1.0")
+	require.NoError(t, fsext.WriteFile(ts.FS, filepath.Join("snapshots", "other.tar"), []byte("not a snapshot"), 0o644))
+
+	entries, err := loadHistory(ts.GlobalState, "snapshots", "github.com/grafana/xk6-faker")
+	require.NoError(t, err)
+	require.Equal(t, []*historyEntry{
+		{Date: day(1, 1), Latest: "v0.2.0"},
+		{Date: day(2, 1), Latest: "v0.3.0", Constraints: ">=v0.50"},
+		{Date: day(4, 1), Latest: "v0.4.0", Constraints: ">=v1.0"},
+	}, entries)
+
+	require.NoError(t, outputHistory(ts.GlobalState, entries, dateFormat{loc: time.UTC}))
+	require.Equal(t, `DATE        LATEST  CONSTRAINTS
+2025-01-01  v0.2.0  -
+2025-02-01  v0.3.0  >=v0.50
+2025-04-01  v0.4.0  >=v1.0
+`, ts.Stdout.String())
+
+	_, err = loadHistory(ts.GlobalState, "snapshots", "xk6-missing")
+	require.ErrorIs(t, err, errNoHistory)
+}
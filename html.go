@@ -0,0 +1,98 @@
+package explore
+
+import (
+	"html/template"
+	"strings"
+	"time"
+
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+// tableHTML is the template of the standalone HTML page of the html format, e.g. for an
+// internal page of the approved extensions published from CI. The columns are sorted by
+// clicking on their header, by a script embedded so the page needs nothing else.
+//
+//nolint:gochecknoglobals
+var tableHTML = template.Must(template.New("table").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>k6 extensions</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { padding: 0.3em 0.8em; text-align: left; vertical-align: top; border-bottom: 1px solid #ddd; }
+th { cursor: pointer; user-select: none; }
+th[data-order="asc"]::after { content: " \25B2"; }
+th[data-order="desc"]::after { content: " \25BC"; }
+</style>
+</head>
+<body>
+<h1>k6 extensions</h1>
+<p>{{len .Rows}} extensions, fetched at {{.FetchedAt}}.</p>
+<table>
+<thead>
+<tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{- range .Rows}}
+<tr>{{range .}}<td>{{if .URL}}<a href="{{.URL}}">{{.Value}}</a>{{else}}{{.Value}}{{end}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+<script>
+document.querySelectorAll("th").forEach((th, column) => th.addEventListener("click", () => {
+  const body = th.closest("table").tBodies[0];
+  const asc = th.dataset.order !== "asc";
+  th.parentElement.querySelectorAll("th").forEach((other) => delete other.dataset.order);
+  th.dataset.order = asc ? "asc" : "desc";
+  const rows = Array.from(body.rows).sort((a, b) =>
+    a.cells[column].textContent.localeCompare(b.cells[column].textContent, undefined, { numeric: true }));
+  if (!asc) rows.reverse();
+  body.append(...rows);
+}));
+</script>
+</body>
+</html>
+`))
+
+// htmlCell is a cell of the html format, linked to the repository in the module column.
+type htmlCell struct {
+	Value string
+	URL   string
+}
+
+// outputHTML writes the columns of the layout as a standalone HTML page. The values are
+// neither abbreviated nor truncated, and the module paths link to the repositories.
+func outputHTML(gs *state.GlobalState, extensions []*extension, layout tableLayout, fetchedAt time.Time) error {
+	layout.longValues, layout.notrunc = true, true
+
+	headers := make([]string, 0, len(layout.columns))
+	for _, name := range layout.columns {
+		headers = append(headers, strings.ToUpper(name[:1])+name[1:])
+	}
+
+	rows := make([][]htmlCell, 0, len(extensions))
+
+	for _, ext := range extensions {
+		row := make([]htmlCell, 0, len(layout.columns))
+
+		for _, name := range layout.columns {
+			cell := htmlCell{Value: tableColumns[name].value(ext, layout)}
+			if name == "module" && ext.Repo != nil {
+				cell.URL = ext.Repo.URL
+			}
+
+			row = append(row, cell)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return tableHTML.Execute(gs.Stdout, map[string]any{
+		"Headers":   headers,
+		"Rows":      rows,
+		"FetchedAt": fetchedAt.UTC().Format(time.RFC3339),
+	})
+}
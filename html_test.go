@@ -0,0 +1,36 @@
+package explore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestOutputHTML(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{
+			Module: "github.com/grafana/xk6-faker", Tier: "official", Imports: []string{"k6/x/faker"},
+			Description: "Generate <fake> data", Repo: &repository{URL: "https://github.com/grafana/xk6-faker", Stars: 120},
+		},
+		{Module: "github.com/example/xk6-output-foo", Outputs: []string{"foo"}},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	opts := options{gs: ts.GlobalState, format: formatHTML, columns: columnList{"module", "type", "stars", "description"}}
+
+	require.NoError(t, output(opts, extensions, time.Date(2025, 6, 1, 2, 0, 0, 0, time.FixedZone("CEST", 7200))))
+
+	page := ts.Stdout.String()
+
+	require.Contains(t, page, "<p>2 extensions, fetched at 2025-06-01T00:00:00Z.</p>")
+	require.Contains(t, page, "<tr><th>Module</th><th>Type</th><th>Stars</th><th>Description</th></tr>")
+	require.Contains(t, page, `<tr><td><a href="https://github.com/grafana/xk6-faker">github.com/grafana/xk6-faker</a></td>`+
+		`<td>JavaScript</td><td>120</td><td>Generate &lt;fake&gt; data</td></tr>`)
+	require.Contains(t, page, "<tr><td>github.com/example/xk6-output-foo</td><td>Output</td><td></td><td></td></tr>")
+	require.Contains(t, page, "<script>")
+}
@@ -0,0 +1,104 @@
+package explore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/itchyny/gojq"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+var (
+	errInvalidJQ = errors.New("invalid --jq query")
+	errJQ        = errors.New("--jq query failed")
+)
+
+// jqQuery is the jq query given by the --jq flag, applied to the JSON output without
+// requiring a jq binary, e.g. on minimal CI images.
+type jqQuery struct {
+	spec string
+	code *gojq.Code
+}
+
+func (q *jqQuery) String() string {
+	if q == nil {
+		return ""
+	}
+
+	return q.spec
+}
+
+func (q *jqQuery) Set(s string) error {
+	query, err := gojq.Parse(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidJQ, err)
+	}
+
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidJQ, err)
+	}
+
+	*q = jqQuery{spec: s, code: code}
+
+	return nil
+}
+
+func (q *jqQuery) Type() string {
+	return "query"
+}
+
+// isSet reports whether a query was given.
+func (q *jqQuery) isSet() bool {
+	return q != nil && q.code != nil
+}
+
+// outputJQ writes the results of the query run on the JSON representation of v, one per line.
+// Strings are written without quotes, like with jq --raw-output, other values as indented JSON.
+// The errors raised while running the query on the data, e.g. adding a number to a string,
+// are reported as failures of the query, not as invalid queries.
+func outputJQ(gs *state.GlobalState, q *jqQuery, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	// gojq works on the generic JSON values, not on Go structs.
+	var input any
+
+	if err := json.Unmarshal(data, &input); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(gs.Stdout)
+	encoder.SetIndent("", "  ")
+
+	iter := q.code.RunWithContext(gs.Ctx, input)
+
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+
+		if err, isErr := result.(error); isErr {
+			var halt *gojq.HaltError
+			if errors.As(err, &halt) && halt.Value() == nil {
+				return nil
+			}
+
+			return fmt.Errorf("%w: %w", errJQ, err)
+		}
+
+		if s, isString := result.(string); isString {
+			_, _ = fmt.Fprintln(gs.Stdout, s)
+
+			continue
+		}
+
+		if err := encoder.Encode(result); err != nil {
+			return err
+		}
+	}
+}
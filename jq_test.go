@@ -0,0 +1,67 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestOutputJQ(t *testing.T) {
+	t.Parallel()
+
+	extensions := toJSONList([]*extension{
+		{Module: "github.com/grafana/xk6-faker", Tier: "official", Imports: []string{"k6/x/faker"}},
+		{Module: "github.com/grafana/xk6-dashboard", Subcommands: []string{"dashboard"}},
+	})
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "raw strings",
+			query: ".[].module",
+			want:  "github.com/grafana/xk6-faker\ngithub.com/grafana/xk6-dashboard\n",
+		},
+		{name: "computed fields", query: `map(select(.tier == "official")) | length`, want: "1\n"},
+		{name: "objects", query: `.[1] | {module, kinds}`, want: `{
+  "kinds": [
+    "subcommand"
+  ],
+  "module": "github.com/grafana/xk6-dashboard"
+}
+`},
+		{name: "empty", query: "empty", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var q jqQuery
+
+			require.NoError(t, q.Set(tt.query))
+
+			ts := cmdtests.NewGlobalTestState(t)
+
+			require.NoError(t, outputJQ(ts.GlobalState, &q, extensions))
+			require.Equal(t, tt.want, ts.Stdout.String())
+		})
+	}
+
+	var q jqQuery
+
+	require.ErrorIs(t, q.Set(".[] |"), errInvalidJQ)
+	require.ErrorIs(t, q.Set("unknown_function"), errInvalidJQ)
+
+	// The query is valid, it fails on the data.
+	for _, query := range []string{`error("boom")`, `.[] | .module + 1`} {
+		require.NoError(t, q.Set(query))
+
+		err := outputJQ(cmdtests.NewGlobalTestState(t).GlobalState, &q, extensions)
+		require.ErrorIs(t, err, errJQ, query)
+		require.NotErrorIs(t, err, errInvalidJQ, query)
+	}
+}
@@ -0,0 +1,114 @@
+package explore
+
+import (
+	"fmt"
+
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+// outputSchema is the JSON Schema of the JSON output, printed by --json-schema so that
+// consumers can generate typed clients and check their compatibility in their own CI.
+// It describes both the array of the --json output and the object of --json-meta, and the
+// lines of the ndjson format in $defs. It has to follow extensionJSON, jsonMeta and
+// ndjsonLine, the tests check that their properties match.
+const outputSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "k6 x explore JSON output",
+  "description": "The extensions listed by k6 x explore --json, or wrapped with --json-meta. Schema version 1.",
+  "oneOf": [
+    {
+      "type": "array",
+      "items": { "$ref": "#/$defs/extension" }
+    },
+    { "$ref": "#/$defs/meta" }
+  ],
+  "$defs": {
+    "meta": {
+      "type": "object",
+      "description": "The extensions with where they came from and the version of the format, the output of --json-meta and of show --json.",
+      "required": ["schemaVersion", "catalogURL", "fetchedAt", "cacheHit", "totalCount", "filteredCount", "extensions"],
+      "properties": {
+        "schemaVersion": { "type": "integer", "const": 1, "description": "Version of the JSON output, increased on the changes which may break its consumers." },
+        "catalogURL": { "type": "string", "description": "URL of the registry catalog, or the --catalog snapshot." },
+        "fetchedAt": { "type": "string", "format": "date-time", "description": "Time the catalog was loaded." },
+        "cacheHit": { "type": "boolean", "description": "True when the catalog was reused from a previous invocation with --reuse-fetch." },
+        "totalCount": { "type": "integer", "minimum": 0, "description": "Number of extensions in the catalog, 1 when show fetched the extension alone." },
+        "filteredCount": { "type": "integer", "minimum": 0, "description": "Number of extensions listed." },
+        "extensions": { "type": "array", "items": { "$ref": "#/$defs/extension" } }
+      }
+    },
+    "line": {
+      "description": "A line of the ndjson format: an extension with the version of the format.",
+      "allOf": [{ "$ref": "#/$defs/extension" }],
+      "required": ["schemaVersion"],
+      "properties": {
+        "schemaVersion": { "type": "integer", "const": 1 }
+      }
+    },
+    "extension": {
+      "type": "object",
+      "required": ["module", "tier", "type", "latestStable", "latestPrerelease", "kinds", "deprecated"],
+      "properties": {
+        "module": { "type": "string", "description": "Go module path." },
+        "tier": { "type": "string", "description": "Tier, lowercase, community when missing.", "examples": ["official", "community"] },
+        "type": { "type": "string", "description": "Main extension type, the first of kinds, empty if there is none.", "examples": ["javascript", "output", "subcommand"] },
+        "description": { "type": "string" },
+        "latest": { "type": "string", "description": "Latest version: the newest stable release unless --include-prerelease is given." },
+        "latestStable": { "type": "string", "description": "Newest version which is not a prerelease, empty if there is none." },
+        "latestPrerelease": { "type": "string", "description": "Newest prerelease if it is newer than latestStable, empty otherwise." },
+        "versions": { "$ref": "#/$defs/strings", "description": "Version tags." },
+        "kinds": { "$ref": "#/$defs/strings", "description": "Extension types: javascript, output, subcommand, then the keys of capabilities, e.g. secret-source. The set is open, new extension points add kinds.", "examples": [["javascript", "output"], ["secret-source"]] },
+        "imports": { "$ref": "#/$defs/strings", "description": "JavaScript import paths." },
+        "outputs": { "$ref": "#/$defs/strings", "description": "Output names." },
+        "subcommands": { "$ref": "#/$defs/strings", "description": "Subcommand names." },
+        "capabilities": {
+          "type": "object",
+          "description": "Other extension points, keyed by type, e.g. secret-source.",
+          "additionalProperties": { "$ref": "#/$defs/strings" }
+        },
+        "categories": { "$ref": "#/$defs/strings" },
+        "products": { "$ref": "#/$defs/strings", "description": "k6 products supporting the extension, e.g. oss, cloud." },
+        "constraints": { "type": "string", "description": "k6 version constraints." },
+        "repo": { "$ref": "#/$defs/repository" },
+        "notes": { "type": "string", "description": "Annotation from the notes file." },
+        "platforms": { "$ref": "#/$defs/strings", "description": "Supported platforms as os/arch pairs, omitted when all are supported." },
+        "cgo": { "type": "boolean", "description": "True when building the extension requires cgo." },
+        "deprecated": { "type": "boolean", "description": "True when the extension is deprecated or its repository archived." },
+        "deprecation": { "$ref": "#/$defs/deprecation" }
+      }
+    },
+    "repository": {
+      "type": "object",
+      "required": ["url"],
+      "properties": {
+        "url": { "type": "string" },
+        "owner": { "type": "string", "description": "User or organization owning the repository." },
+        "archived": { "type": "boolean" },
+        "timestamp": { "type": "number", "description": "Time of the last update, in Unix seconds." },
+        "stars": { "type": "integer", "minimum": 0 },
+        "license": { "type": "string", "description": "SPDX identifier of the license." }
+      }
+    },
+    "deprecation": {
+      "type": "object",
+      "description": "Deprecation timeline published by the registry.",
+      "properties": {
+        "since": { "type": "string", "description": "Date of the deprecation." },
+        "removal": { "type": "string", "description": "Planned date of the removal from the registry." },
+        "replacement": { "type": "string", "description": "Module path of the extension to migrate to." }
+      }
+    },
+    "strings": {
+      "type": "array",
+      "items": { "type": "string" }
+    }
+  }
+}
+`
+
+// outputJSONSchema prints the JSON Schema of the JSON output.
+func outputJSONSchema(gs *state.GlobalState) error {
+	_, _ = fmt.Fprint(gs.Stdout, outputSchema)
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package explore
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+// schemaDefinition is the part of a schema definition checked against the Go types.
+type schemaDefinition struct {
+	Required   []string                   `json:"required"`
+	Properties map[string]json.RawMessage `json:"properties"`
+}
+
+// jsonFields returns the JSON property names of the struct fields, and those which are
+// always written, i.e. not omitempty.
+func jsonFields(typ reflect.Type) ([]string, []string) {
+	var names, required []string
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+
+		// The fields of the embedded structs are written inline, those of the pointers only when set.
+		if field.Anonymous {
+			embedded := field.Type
+
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			inline, inlineRequired := jsonFields(embedded)
+
+			names = append(names, inline...)
+
+			if field.Type.Kind() != reflect.Pointer {
+				required = append(required, inlineRequired...)
+			}
+
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		names = append(names, name)
+
+		if opts != "omitempty" {
+			required = append(required, name)
+		}
+	}
+
+	return names, required
+}
+
+func TestOutputSchema(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputJSONSchema(ts.GlobalState))
+
+	var schema struct {
+		Defs map[string]schemaDefinition `json:"$defs"`
+	}
+
+	require.NoError(t, json.Unmarshal(ts.Stdout.Bytes(), &schema))
+
+	for def, typ := range map[string]reflect.Type{
+		"meta":        reflect.TypeFor[jsonMeta](),
+		"extension":   reflect.TypeFor[extensionJSON](),
+		"repository":  reflect.TypeFor[repository](),
+		"deprecation": reflect.TypeFor[deprecation](),
+	} {
+		names, required := jsonFields(typ)
+
+		got := schema.Defs[def]
+
+		require.ElementsMatch(t, names, slices.Collect(maps.Keys(got.Properties)), def)
+		require.ElementsMatch(t, required, got.Required, def)
+	}
+
+	var version struct {
+		Const int `json:"const"`
+	}
+
+	for _, def := range []string{"meta", "line"} {
+		require.NoError(t, json.Unmarshal(schema.Defs[def].Properties["schemaVersion"], &version))
+		require.Equal(t, jsonSchemaVersion, version.Const, def)
+	}
+}
@@ -0,0 +1,72 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var errNoVersion = errors.New("no matching version")
+
+const (
+	latestHelpShort = "Print the latest version of an extension"
+	latestHelpLong  = `Print only the latest version of a single extension.
+
+The output is the bare version string, so it can be used directly in shell
+scripts and Makefiles. The latest version is the newest stable release, or
+the newest prerelease if there is no stable release (or with --include-prerelease).
+With --stable-only, prereleases are always ignored.
+
+The extension can be referenced by catalog name, module path or import path.
+`
+	latestHelpExample = `
+# Print the latest version of an extension:
+k6 x explore latest xk6-faker
+
+# Print the latest stable version in a shell script:
+FAKER_VERSION=$(k6 x explore latest xk6-faker --stable-only)
+`
+)
+
+// newLatestCommand creates the "latest" subcommand of explore.
+func newLatestCommand(opts *options) *cobra.Command {
+	var stableOnly bool
+
+	cmd := &cobra.Command{
+		Use:     "latest <name>",
+		Short:   latestHelpShort,
+		Long:    latestHelpLong,
+		Example: latestHelpExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			name, ext, err := loadExtension(*opts, args[0])
+			if err != nil {
+				return err
+			}
+
+			version := latestVersion(ext, stableOnly)
+			if version == "" {
+				return fmt.Errorf("%w: %s", errNoVersion, name)
+			}
+
+			_, _ = fmt.Fprintln(opts.gs.Stdout, version)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&stableOnly, "stable-only", false, "ignore prerelease versions")
+
+	return cmd
+}
+
+// latestVersion returns the latest version of the extension, the latest stable one with
+// stableOnly, empty if there is none.
+func latestVersion(ext *extension, stableOnly bool) string {
+	if stableOnly {
+		return findLatestStable(ext.Versions)
+	}
+
+	return ext.Latest
+}
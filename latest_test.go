@@ -0,0 +1,118 @@
+package explore
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+// latestCatalog returns the catalog of the latest tests: an extension with stable releases
+// and a newer prerelease, one with prereleases only and one without release.
+func latestCatalog() map[string]*extension {
+	return map[string]*extension{
+		"xk6-faker":      {Module: "github.com/grafana/xk6-faker", Versions: []string{"v0.4.3", "v0.5.0-rc.1", "v0.4.4"}},
+		"xk6-preview":    {Module: "github.com/grafana/xk6-preview", Versions: []string{"v0.1.0-rc.1", "v0.1.0-rc.2"}},
+		"xk6-unreleased": {Module: "github.com/grafana/xk6-unreleased", Versions: []string{}},
+	}
+}
+
+func TestLatestVersion(t *testing.T) {
+	t.Parallel()
+
+	catalog := latestCatalog()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, found := strings.CutPrefix(strings.TrimSuffix(r.URL.Path, ".json"), "/v2/catalog/")
+		if ext := catalog[name]; found && ext != nil {
+			// The records of the test catalog have only fields of the v2 schema.
+			w.Header().Set("Content-Type", mediaTypeCatalogV2)
+			_ = json.NewEncoder(w).Encode(ext)
+
+			return
+		}
+
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name       string
+		query      string
+		stableOnly bool
+		want       string
+		wantErr    error
+	}{
+		{name: "stable", query: "xk6-faker", want: "v0.4.4"},
+		{name: "stable only", query: "xk6-faker", stableOnly: true, want: "v0.4.4"},
+		{name: "prerelease only", query: "xk6-preview", want: "v0.1.0-rc.2"},
+		{name: "prerelease only, stable only", query: "xk6-preview", stableOnly: true, want: ""},
+		{name: "empty version list", query: "xk6-unreleased", want: ""},
+		{name: "empty version list, stable only", query: "xk6-unreleased", stableOnly: true, want: ""},
+		{name: "unknown module", query: "xk6-unknown", wantErr: errExtensionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ext, err := newCatalogFetcher(nil).getExtension(t.Context(), server.URL+"/v2/catalog.json", tt.query)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, latestVersion(ext, tt.stableOnly))
+		})
+	}
+}
+
+func TestLatestCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr error
+	}{
+		{name: "catalog name", args: []string{"xk6-faker"}, want: "v0.4.4\n"},
+		{name: "module path", args: []string{"github.com/grafana/xk6-faker", "--stable-only"}, want: "v0.4.4\n"},
+		{name: "prerelease only", args: []string{"xk6-preview"}, want: "v0.1.0-rc.2\n"},
+		{name: "prerelease only, stable only", args: []string{"xk6-preview", "--stable-only"}, wantErr: errNoVersion},
+		{name: "empty version list", args: []string{"xk6-unreleased"}, wantErr: errNoVersion},
+		{name: "unknown module", args: []string{"github.com/grafana/xk6-unknown"}, wantErr: errExtensionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := cmdtests.NewGlobalTestState(t)
+
+			_, err := exportSnapshot(ts.GlobalState, latestCatalog(), "", "catalog.tar", time.Now())
+			require.NoError(t, err)
+
+			cmd := newLatestCommand(&options{gs: ts.GlobalState, catalog: "bundle://catalog.tar"})
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			err = cmd.RunE(cmd, cmd.Flags().Args())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Empty(t, ts.Stdout.String())
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, ts.Stdout.String())
+		})
+	}
+}
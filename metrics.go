@@ -0,0 +1,65 @@
+package explore
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+const metricPrefix = "k6_explore_"
+
+// outputProm writes catalog statistics in the Prometheus text exposition format,
+// suitable for a textfile collector or a push gateway.
+func outputProm(gs *state.GlobalState, extensions []*extension, fetchedAt time.Time) error {
+	writeProm(gs.Stdout, extensions, fetchedAt)
+
+	return nil
+}
+
+func writeProm(w io.Writer, extensions []*extension, fetchedAt time.Time) {
+	byTier := make(map[string]int)
+	byType := make(map[string]int)
+	versions := 0
+
+	for _, ext := range extensions {
+		byTier[strings.ToLower(extensionTier(ext))]++
+		byType[strings.ToLower(extensionType(ext))]++
+		versions += len(ext.Versions)
+	}
+
+	writeMetric(w, "extensions", "Number of extensions in the catalog.", float64(len(extensions)))
+	writeLabeledMetric(w, "extensions_by_tier", "Number of extensions by tier.", "tier", byTier)
+	writeLabeledMetric(w, "extensions_by_type", "Number of extensions by type.", "type", byType)
+	writeMetric(w, "versions", "Number of extension versions in the catalog.", float64(versions))
+	writeMetric(w, "catalog_fetch_timestamp_seconds",
+		"Unix time the catalog was fetched from the registry.", float64(fetchedAt.Unix()))
+}
+
+func writeMetric(w io.Writer, name string, help string, value float64) {
+	_, _ = fmt.Fprintf(w, "# HELP %s%s %s\n# TYPE %s%s gauge\n%s%s %s\n",
+		metricPrefix, name, help, metricPrefix, name, metricPrefix, name, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+func writeLabeledMetric(w io.Writer, name string, help string, label string, values map[string]int) {
+	_, _ = fmt.Fprintf(w, "# HELP %s%s %s\n# TYPE %s%s gauge\n", metricPrefix, name, help, metricPrefix, name)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(w, "%s%s{%s=\"%s\"} %d\n", metricPrefix, name, label, escapeLabelValue(key), values[key])
+	}
+}
+
+func escapeLabelValue(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
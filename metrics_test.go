@@ -0,0 +1,48 @@
+package explore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestOutputProm(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Tier: "official", Versions: []string{"v0.4.4", "v0.4.3"}, Imports: []string{"k6/x/faker"}},
+		{Tier: "official", Versions: []string{"v1.0.0"}, Outputs: []string{"prometheus"}},
+		{Tier: "community", Versions: []string{"v0.7.4"}, Subcommands: []string{"dashboard"}},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputProm(ts.GlobalState, extensions, time.Unix(1700000000, 0)))
+	require.Equal(t, `# HELP k6_explore_extensions Number of extensions in the catalog.
+# TYPE k6_explore_extensions gauge
+k6_explore_extensions 3
+# HELP k6_explore_extensions_by_tier Number of extensions by tier.
+# TYPE k6_explore_extensions_by_tier gauge
+k6_explore_extensions_by_tier{tier="community"} 1
+k6_explore_extensions_by_tier{tier="official"} 2
+# HELP k6_explore_extensions_by_type Number of extensions by type.
+# TYPE k6_explore_extensions_by_type gauge
+k6_explore_extensions_by_type{type="javascript"} 1
+k6_explore_extensions_by_type{type="output"} 1
+k6_explore_extensions_by_type{type="subcommand"} 1
+# HELP k6_explore_versions Number of extension versions in the catalog.
+# TYPE k6_explore_versions gauge
+k6_explore_versions 4
+# HELP k6_explore_catalog_fetch_timestamp_seconds Unix time the catalog was fetched from the registry.
+# TYPE k6_explore_catalog_fetch_timestamp_seconds gauge
+k6_explore_catalog_fetch_timestamp_seconds 1700000000
+`, ts.Stdout.String())
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, `a\"b\\c\nd`, escapeLabelValue("a\"b\\c\nd"))
+}
@@ -0,0 +1,35 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var errNetworkDisabled = errors.New("network access disabled by --no-network")
+
+// noNetwork is an http.RoundTripper failing every request without any connection attempt,
+// used with --no-network so that sandboxed builds can rely on the command staying offline.
+type noNetwork struct{}
+
+// RoundTrip fails the request, naming it so that the code path needing the network is known.
+func (noNetwork) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("%w: %s %s", errNetworkDisabled, req.Method, req.URL.Redacted())
+}
+
+// guardNetwork makes every request of the client fail with --no-network.
+// It is called after attaching the trace recorder, whose transport it wraps instead of
+// replacing it, so that the trace file still shows the refused requests.
+func guardNetwork(opts options, client *http.Client) {
+	if !opts.noNetwork {
+		return
+	}
+
+	if recorded, ok := client.Transport.(*harTransport); ok {
+		recorded.transport = noNetwork{}
+
+		return
+	}
+
+	client.Transport = noNetwork{}
+}
@@ -0,0 +1,44 @@
+package explore
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestNoNetwork(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(srv.Close)
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	_, err := newFetcher(options{gs: ts.GlobalState, noNetwork: true}).getExtensionCatalog(t.Context(), srv.URL)
+	require.ErrorIs(t, err, errNetworkDisabled)
+	require.ErrorContains(t, err, "GET "+srv.URL)
+	require.Zero(t, requests.Load())
+
+	_, err = newFetcher(options{gs: ts.GlobalState}).getExtensionCatalog(t.Context(), srv.URL)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), requests.Load())
+
+	recorder := newHARRecorder()
+
+	fetcher := newFetcher(options{gs: ts.GlobalState, noNetwork: true, recorder: recorder})
+
+	_, err = fetcher.getExtensionCatalog(t.Context(), srv.URL)
+	require.ErrorIs(t, err, errNetworkDisabled)
+	require.Equal(t, int32(1), requests.Load())
+	require.Len(t, recorder.entries, 1, "the refused requests must be traced")
+	require.Contains(t, recorder.entries[0].Error, errNetworkDisabled.Error())
+}
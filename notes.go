@@ -0,0 +1,42 @@
+package explore
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+// loadNotes reads a notes file: a JSON object mapping module paths (or catalog names)
+// to freeform annotations, e.g. {"github.com/grafana/xk6-faker": "approved 2024-11"}.
+func loadNotes(gs *state.GlobalState, path string) (map[string]string, error) {
+	data, err := fsext.ReadFile(gs.FS, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var notes map[string]string
+
+	if err := json.Unmarshal(data, &notes); err != nil {
+		return nil, fmt.Errorf("invalid notes file %s: %w", path, err)
+	}
+
+	return notes, nil
+}
+
+// applyNotes merges the annotations of the notes file into the catalog.
+// Annotations of extensions missing from the catalog are reported at debug level,
+// as notes files usually outlive the extensions they mention.
+func applyNotes(gs *state.GlobalState, catalog map[string]*extension, notes map[string]string) {
+	for name, note := range notes {
+		_, ext := lookupExtension(catalog, name)
+		if ext == nil {
+			gs.Logger.Debugf("Note for %s ignored: extension not found in the catalog", name)
+
+			continue
+		}
+
+		ext.Notes = note
+	}
+}
@@ -0,0 +1,49 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestLoadNotes(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	data := []byte(`{"github.com/grafana/xk6-faker": "approved 2024-11"}`)
+	require.NoError(t, fsext.WriteFile(ts.FS, "/team/notes.json", data, 0o600))
+	require.NoError(t, fsext.WriteFile(ts.FS, "/team/invalid.json", []byte(`["not", "an", "object"]`), 0o600))
+
+	notes, err := loadNotes(ts.GlobalState, "/team/notes.json")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"github.com/grafana/xk6-faker": "approved 2024-11"}, notes)
+
+	_, err = loadNotes(ts.GlobalState, "/team/invalid.json")
+	require.Error(t, err)
+
+	_, err = loadNotes(ts.GlobalState, "/team/missing.json")
+	require.Error(t, err)
+}
+
+func TestApplyNotes(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker": {Module: "github.com/grafana/xk6-faker"},
+		"xk6-tls":   {Module: "github.com/grafana/xk6-tls"},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	applyNotes(ts.GlobalState, catalog, map[string]string{
+		"github.com/grafana/xk6-faker": "approved 2024-11",
+		"xk6-tls":                      "blocked: license",
+		"xk6-removed":                  "ignored",
+	})
+
+	require.Equal(t, "approved 2024-11", catalog["xk6-faker"].Notes)
+	require.Equal(t, "blocked: license", catalog["xk6-tls"].Notes)
+}
@@ -1,20 +1,58 @@
 package explore
 
 import (
+	"cmp"
 	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/Masterminds/semver/v3"
 	"go.k6.io/k6/v2/cmd/state"
 )
 
 var (
-	errInvalidKind = errors.New("invalid type: allowed values are javascript, output, subcommand")
-	errInvalidTier = errors.New("invalid tier: allowed values are official, community")
+	errInvalidKind     = errors.New("invalid type")
+	errInvalidTier     = errors.New("invalid tier")
+	errInvalidFormat   = errors.New("invalid format: allowed values are table, wide, json, ndjson, prom, csv, tsv, html, go-template=TEMPLATE, name, module, module-version")
+	errInvalidEmit     = errors.New("invalid emit target")
+	errInvalidEllipsis = errors.New("invalid ellipsis: allowed values are ascii, unicode")
+	errInvalidDelim    = errors.New("invalid delimiter: expected a single character other than a quote or line break")
+	errInvalidNumbers  = errors.New("invalid number format: allowed values are plain, grouped, compact")
+	errInvalidGroupBy  = errors.New("invalid --group-by value: allowed values are tier, type, owner")
+	errInvalidColumn   = errors.New("invalid column")
+	errInvalidMatch    = errors.New("invalid match pattern")
+	errInvalidStars    = errors.New("invalid star count")
+	errUnknownK6       = errors.New("unable to detect the k6 version for --compat")
+	errInvalidK6       = errors.New("invalid --k6-version value")
+	errInvalidVersions = errors.New("invalid --version-constraint value")
+	errInvalidCgo      = errors.New("invalid --cgo value: expected true or false")
+	errInvalidSort     = errors.New("invalid sort key")
+	errInvalidSince    = errors.New("invalid --updated-since value: expected a date (2024-01-01) or an age (90d, 2w, 6mo, 1y)")
 )
 
 type kind string
 
 type tier string
 
+type format string
+
+type emitTarget string
+
+type ellipsis string
+
+// delimiter is the field delimiter of the CSV output, a comma by default.
+type delimiter rune
+
+type numberStyle string
+
+type groupBy string
+
 const (
 	kindJavaScript kind = "javascript"
 	kindOutput     kind = "output"
@@ -22,12 +60,53 @@ const (
 
 	tierOfficial  tier = "official"
 	tierCommunity tier = "community"
+
+	formatTable  format = "table"
+	formatWide   format = "wide"
+	formatJSON   format = "json"
+	formatNDJSON format = "ndjson"
+	formatProm   format = "prom"
+	formatCSV    format = "csv"
+	formatTSV    format = "tsv"
+	formatHTML   format = "html"
+
+	formatName          format = "name"
+	formatModule        format = "module"
+	formatModuleVersion format = "module-version"
+	// formatTemplate is followed by the template, e.g. go-template={{.Module}}@{{.Latest}}.
+	formatTemplate format = "go-template"
+
+	emitGoGetTarget emitTarget = "go-get"
+	emitCloudTarget emitTarget = "cloud"
+
+	ellipsisASCII   ellipsis = "ascii"
+	ellipsisUnicode ellipsis = "unicode"
+
+	numbersPlain   numberStyle = "plain"
+	numbersGrouped numberStyle = "grouped"
+	numbersCompact numberStyle = "compact"
+
+	groupByTier  groupBy = "tier"
+	groupByType  groupBy = "type"
+	groupByOwner groupBy = "owner"
 )
 
 //nolint:gochecknoglobals
 var (
 	kindValues = []string{string(kindJavaScript), string(kindOutput), string(kindSubcommand)}
 	tierValues = []string{string(tierOfficial), string(tierCommunity)}
+
+	formatValues = []string{
+		string(formatTable), string(formatWide), string(formatJSON), string(formatNDJSON), string(formatProm),
+		string(formatCSV), string(formatTSV), string(formatHTML), string(formatTemplate),
+		string(formatName), string(formatModule), string(formatModuleVersion),
+	}
+
+	ellipsisValues = []string{string(ellipsisASCII), string(ellipsisUnicode)}
+
+	numberValues = []string{string(numbersPlain), string(numbersGrouped), string(numbersCompact)}
+
+	groupValues = []string{string(groupByTier), string(groupByType), string(groupByOwner)}
 )
 
 func (k *kind) String() string {
@@ -38,15 +117,16 @@ func (k *kind) String() string {
 	return string(*k)
 }
 
+// Set accepts any type name, as k6 may introduce new extension types at any time.
+// The value is validated against the types of the fetched catalog, see validate.
 func (k *kind) Set(s string) error {
-	switch kind(s) {
-	case kindJavaScript, kindOutput, kindSubcommand:
-		*k = kind(s)
-
-		return nil
-	default:
-		return errInvalidKind
+	if s == "" {
+		return fmt.Errorf("%w: empty value", errInvalidKind)
 	}
+
+	*k = kind(s)
+
+	return nil
 }
 
 func (k *kind) Type() string {
@@ -67,13 +147,91 @@ func (k *kind) filter(ext *extension) bool {
 		prop = ext.Outputs
 	case kindSubcommand:
 		prop = ext.Subcommands
-	default:
+	case "":
 		return true
+	default:
+		prop = ext.Capabilities[string(*k)]
 	}
 
 	return len(prop) > 0
 }
 
+// validate checks the type against the types present in the catalog.
+func (k *kind) validate(known []string) error {
+	if k == nil || *k == "" || slices.Contains(known, string(*k)) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: allowed values are %s", errInvalidKind, strings.Join(known, ", "))
+}
+
+// kindSet is the set of types given by the repeatable --type flag.
+type kindSet []kind
+
+func (ks *kindSet) String() string {
+	if ks == nil {
+		return ""
+	}
+
+	values := make([]string, 0, len(*ks))
+	for _, k := range *ks {
+		values = append(values, string(k))
+	}
+
+	return strings.Join(values, ",")
+}
+
+// Set accepts a single type or a comma-separated list. Repeated flags accumulate.
+func (ks *kindSet) Set(s string) error {
+	for value := range strings.SplitSeq(s, ",") {
+		var k kind
+
+		if err := k.Set(strings.TrimSpace(value)); err != nil {
+			return err
+		}
+
+		if !slices.Contains(*ks, k) {
+			*ks = append(*ks, k)
+		}
+	}
+
+	return nil
+}
+
+func (ks *kindSet) Type() string {
+	return "type"
+}
+
+// filter matches the extensions of any of the types.
+func (ks *kindSet) filter(ext *extension) bool {
+	if ks == nil || len(*ks) == 0 {
+		return true
+	}
+
+	for _, k := range *ks {
+		if k.filter(ext) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validate checks each type against the types present in the catalog.
+func (ks *kindSet) validate(known []string) error {
+	if ks == nil {
+		return nil
+	}
+
+	for _, k := range *ks {
+		if err := k.validate(known); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (t *tier) String() string {
 	if t == nil {
 		return ""
@@ -82,15 +240,16 @@ func (t *tier) String() string {
 	return string(*t)
 }
 
+// Set accepts any tier name, as the registry may introduce new tiers at any time.
+// The value is validated against the tiers of the fetched catalog, see validate.
 func (t *tier) Set(s string) error {
-	switch tier(s) {
-	case tierOfficial, tierCommunity:
-		*t = tier(s)
-
-		return nil
-	default:
-		return errInvalidTier
+	if s == "" {
+		return fmt.Errorf("%w: empty value", errInvalidTier)
 	}
+
+	*t = tier(s)
+
+	return nil
 }
 
 func (t *tier) Type() string {
@@ -98,30 +257,1079 @@ func (t *tier) Type() string {
 }
 
 func (t *tier) filter(ext *extension) bool {
-	if t == nil {
+	if t == nil || *t == "" {
+		return true
+	}
+
+	return ext.Tier == string(*t)
+}
+
+// validate checks the tier against the tiers present in the catalog.
+func (t *tier) validate(known []string) error {
+	if t == nil || *t == "" || slices.Contains(known, string(*t)) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: allowed values are %s", errInvalidTier, strings.Join(known, ", "))
+}
+
+// tierSet is the set of tiers given by the repeatable --tier flag.
+type tierSet []tier
+
+func (ts *tierSet) String() string {
+	if ts == nil {
+		return ""
+	}
+
+	values := make([]string, 0, len(*ts))
+	for _, t := range *ts {
+		values = append(values, string(t))
+	}
+
+	return strings.Join(values, ",")
+}
+
+// Set accepts a single tier or a comma-separated list. Repeated flags accumulate.
+func (ts *tierSet) Set(s string) error {
+	for value := range strings.SplitSeq(s, ",") {
+		var t tier
+
+		if err := t.Set(strings.TrimSpace(value)); err != nil {
+			return err
+		}
+
+		if !slices.Contains(*ts, t) {
+			*ts = append(*ts, t)
+		}
+	}
+
+	return nil
+}
+
+func (ts *tierSet) Type() string {
+	return "tier"
+}
+
+// filter matches the extensions of any of the tiers.
+func (ts *tierSet) filter(ext *extension) bool {
+	if ts == nil || len(*ts) == 0 {
 		return true
 	}
 
-	var value bool
+	for _, t := range *ts {
+		if t.filter(ext) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validate checks each tier against the tiers present in the catalog.
+func (ts *tierSet) validate(known []string) error {
+	if ts == nil {
+		return nil
+	}
+
+	for _, t := range *ts {
+		if err := t.validate(known); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *format) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return string(*f)
+}
+
+func (f *format) Set(s string) error {
+	if spec, ok := strings.CutPrefix(s, templatePrefix); ok {
+		if _, err := parseTemplate(spec); err != nil {
+			return err
+		}
+
+		*f = format(s)
+
+		return nil
+	}
+
+	switch format(s) {
+	case formatTable, formatWide, formatJSON, formatNDJSON, formatProm, formatCSV, formatTSV, formatHTML,
+		formatName, formatModule, formatModuleVersion:
+		*f = format(s)
+
+		return nil
+	default:
+		return errInvalidFormat
+	}
+}
+
+func (f *format) Type() string {
+	return "format"
+}
+
+// template returns the template of the go-template format, and whether the format is one.
+func (f format) template() (string, bool) {
+	return strings.CutPrefix(string(f), templatePrefix)
+}
+
+func (e *emitTarget) String() string {
+	if e == nil {
+		return ""
+	}
+
+	return string(*e)
+}
+
+// Set accepts the built-in and the registered emit targets, see RegisterEmitter.
+func (e *emitTarget) Set(s string) error {
+	if _, found := lookupEmitter(s); !found {
+		return fmt.Errorf("%w: allowed values are %s", errInvalidEmit, strings.Join(emitterNames(), ", "))
+	}
+
+	*e = emitTarget(s)
+
+	return nil
+}
+
+func (e *emitTarget) Type() string {
+	return "target"
+}
+
+func (e *ellipsis) String() string {
+	if e == nil {
+		return ""
+	}
+
+	return string(*e)
+}
+
+func (e *ellipsis) Set(s string) error {
+	switch ellipsis(s) {
+	case ellipsisASCII, ellipsisUnicode:
+		*e = ellipsis(s)
+
+		return nil
+	default:
+		return errInvalidEllipsis
+	}
+}
+
+func (e *ellipsis) Type() string {
+	return "style"
+}
+
+// indicator returns the truncation indicator of the style, "..." by default.
+func (e *ellipsis) indicator() string {
+	if e != nil && *e == ellipsisUnicode {
+		return "\u2026"
+	}
+
+	return "..."
+}
+
+func (d *delimiter) String() string {
+	if d == nil || *d == 0 {
+		return ""
+	}
+
+	return string(*d)
+}
+
+// Set accepts a single character, e.g. ; for the spreadsheets of the locales using the
+// comma as decimal separator. Quotes and line breaks are reserved by the CSV format.
+func (d *delimiter) Set(s string) error {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 || size != len(s) || r == utf8.RuneError || strings.ContainsRune("\"\r\n", r) {
+		return fmt.Errorf("%w: %q", errInvalidDelim, s)
+	}
+
+	*d = delimiter(r)
 
-	switch *t {
-	case tierOfficial:
-		value = ext.Tier == "official"
-	case tierCommunity:
-		value = ext.Tier == "community"
+	return nil
+}
+
+func (d *delimiter) Type() string {
+	return "char"
+}
+
+// comma returns the delimiter rune, a comma by default.
+func (d *delimiter) comma() rune {
+	if d == nil || *d == 0 {
+		return ','
+	}
+
+	return rune(*d)
+}
+
+func (n *numberStyle) String() string {
+	if n == nil {
+		return ""
+	}
+
+	return string(*n)
+}
+
+func (n *numberStyle) Set(s string) error {
+	switch numberStyle(s) {
+	case numbersPlain, numbersGrouped, numbersCompact:
+		*n = numberStyle(s)
+
+		return nil
 	default:
+		return errInvalidNumbers
+	}
+}
+
+func (n *numberStyle) Type() string {
+	return "style"
+}
+
+// extensionFilter selects the extensions to list.
+type extensionFilter interface {
+	filter(ext *extension) bool
+}
+
+// categories is the list of categories given by the repeatable --category flag.
+type categories []string
+
+func (c *categories) String() string {
+	if c == nil {
+		return ""
+	}
+
+	return strings.Join(*c, ",")
+}
+
+// Set accepts a single category or a comma-separated list. Repeated flags accumulate.
+func (c *categories) Set(s string) error {
+	for category := range strings.SplitSeq(s, ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			*c = append(*c, category)
+		}
+	}
+
+	return nil
+}
+
+func (c *categories) Type() string {
+	return "category"
+}
+
+// filter matches the extensions having any of the categories, ignoring case.
+func (c *categories) filter(ext *extension) bool {
+	if c == nil || len(*c) == 0 {
+		return true
+	}
+
+	for _, want := range *c {
+		for _, category := range ext.Categories {
+			if strings.EqualFold(category, want) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// owners is the list of repository owners given by the repeatable --owner flag.
+// Owners prefixed with "!" are excluded.
+type owners []string
+
+func (o *owners) String() string {
+	if o == nil {
+		return ""
+	}
+
+	return strings.Join(*o, ",")
+}
+
+// Set accepts a single owner or a comma-separated list. Repeated flags accumulate.
+func (o *owners) Set(s string) error {
+	for owner := range strings.SplitSeq(s, ",") {
+		if owner = strings.TrimSpace(owner); owner != "" && owner != "!" {
+			*o = append(*o, owner)
+		}
+	}
+
+	return nil
+}
+
+func (o *owners) Type() string {
+	return "owner"
+}
+
+// filter matches the extensions owned by any of the listed owners and by none of the
+// excluded ones. The owner is taken from the repository metadata and from the owner
+// segment of the module path (github.com/<owner>/...), ignoring case.
+func (o *owners) filter(ext *extension) bool {
+	if o == nil || len(*o) == 0 {
+		return true
+	}
+
+	candidates := extensionOwners(ext)
+	included, hasIncludes := false, false
+
+	for _, owner := range *o {
+		excluded, isExclusion := strings.CutPrefix(owner, "!")
+		if isExclusion {
+			if slices.ContainsFunc(candidates, func(c string) bool { return strings.EqualFold(c, excluded) }) {
+				return false
+			}
+
+			continue
+		}
+
+		hasIncludes = true
+
+		if slices.ContainsFunc(candidates, func(c string) bool { return strings.EqualFold(c, owner) }) {
+			included = true
+		}
+	}
+
+	return included || !hasIncludes
+}
+
+// repoHosts is the list of code hosts given by the repeatable --repo-host flag.
+type repoHosts []string
+
+func (h *repoHosts) String() string {
+	if h == nil {
+		return ""
+	}
+
+	return strings.Join(*h, ",")
+}
+
+// Set accepts a single host or a comma-separated list. Repeated flags accumulate.
+func (h *repoHosts) Set(s string) error {
+	for host := range strings.SplitSeq(s, ",") {
+		// Hosts copied from a browser, e.g. https://gitlab.com/, are normalized like module paths.
+		if host = normalizeModule(host); host != "" {
+			*h = append(*h, host)
+		}
+	}
+
+	return nil
+}
+
+func (h *repoHosts) Type() string {
+	return "host"
+}
+
+// filter matches the extensions whose module path starts with any of the hosts, ignoring case.
+func (h *repoHosts) filter(ext *extension) bool {
+	if h == nil || len(*h) == 0 {
+		return true
+	}
+
+	host, _, _ := strings.Cut(ext.Module, "/")
+
+	return slices.ContainsFunc(*h, func(want string) bool { return strings.EqualFold(host, want) })
+}
+
+// subcommandNames is the list of subcommand names given by the repeatable --subcommand-name flag.
+type subcommandNames []string
+
+func (n *subcommandNames) String() string {
+	if n == nil {
+		return ""
+	}
+
+	return strings.Join(*n, ",")
+}
+
+// Set accepts a single name or a comma-separated list. Repeated flags accumulate.
+func (n *subcommandNames) Set(s string) error {
+	for name := range strings.SplitSeq(s, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			*n = append(*n, name)
+		}
+	}
+
+	return nil
+}
+
+func (n *subcommandNames) Type() string {
+	return "name"
+}
+
+// filter matches the extensions providing any of the subcommands, i.e. "k6 x <name>".
+func (n *subcommandNames) filter(ext *extension) bool {
+	if n == nil || len(*n) == 0 {
+		return true
+	}
+
+	for _, name := range *n {
+		if slices.ContainsFunc(ext.Subcommands, func(sub string) bool { return strings.EqualFold(sub, name) }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// licenses is the list of licenses given by the repeatable --license flag.
+type licenses []string
+
+func (l *licenses) String() string {
+	if l == nil {
+		return ""
+	}
+
+	return strings.Join(*l, ",")
+}
+
+// Set accepts a single license or a comma-separated list. Repeated flags accumulate.
+func (l *licenses) Set(s string) error {
+	for license := range strings.SplitSeq(s, ",") {
+		if license = strings.TrimSpace(license); license != "" {
+			*l = append(*l, license)
+		}
+	}
+
+	return nil
+}
+
+func (l *licenses) Type() string {
+	return "license"
+}
+
+// filter matches the extensions under any of the licenses, ignoring case.
+// Extensions without license metadata never match, as their license is unknown.
+func (l *licenses) filter(ext *extension) bool {
+	if l == nil || len(*l) == 0 {
+		return true
+	}
+
+	if ext.Repo == nil || ext.Repo.License == "" {
+		return false
+	}
+
+	return slices.ContainsFunc(*l, func(want string) bool { return strings.EqualFold(ext.Repo.License, want) })
+}
+
+// minStars is the minimum number of repository stars given by the --min-stars flag.
+type minStars int
+
+func (m *minStars) String() string {
+	if m == nil {
+		return "0"
+	}
+
+	return strconv.Itoa(int(*m))
+}
+
+func (m *minStars) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return fmt.Errorf("%w: %s", errInvalidStars, s)
+	}
+
+	*m = minStars(n)
+
+	return nil
+}
+
+func (m *minStars) Type() string {
+	return "count"
+}
+
+// filter matches the extensions whose repository has at least the given number of stars.
+// Extensions without repository metadata have no stars.
+func (m *minStars) filter(ext *extension) bool {
+	if m == nil || *m == 0 {
+		return true
+	}
+
+	return ext.Repo != nil && ext.Repo.Stars >= int(*m)
+}
+
+// updatedSince is the date or the age given by the --updated-since flag.
+type updatedSince struct {
+	spec  string
+	since time.Time
+	age   time.Duration
+}
+
+func (u *updatedSince) String() string {
+	if u == nil {
+		return ""
+	}
+
+	return u.spec
+}
+
+// Set accepts a date (2024-01-01) or an age relative to now (90d, 2w, 6mo, 1y).
+func (u *updatedSince) Set(s string) error {
+	if age, ok := parseAge(s); ok {
+		*u = updatedSince{spec: s, age: age}
+
+		return nil
+	}
+
+	since, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return fmt.Errorf("%w: %q", errInvalidSince, s)
+	}
+
+	*u = updatedSince{spec: s, since: since}
+
+	return nil
+}
+
+func (u *updatedSince) Type() string {
+	return "date"
+}
+
+// cutoff returns the time from which extensions count as updated.
+func (u *updatedSince) cutoff(now time.Time) time.Time {
+	if u.age > 0 {
+		return now.Add(-u.age)
+	}
+
+	return u.since
+}
+
+// filter matches the extensions whose repository was updated since the cutoff.
+// Extensions without a repository timestamp never match, their update time is unknown.
+func (u *updatedSince) filter(ext *extension) bool {
+	if u == nil || u.spec == "" {
+		return true
+	}
+
+	if ext.Repo == nil || ext.Repo.Timestamp == 0 {
+		return false
+	}
+
+	return !time.Unix(int64(ext.Repo.Timestamp), 0).Before(u.cutoff(time.Now()))
+}
+
+// compatFilter keeps the extensions compatible with the running k6 version, see --compat.
+type compatFilter struct {
+	version *semver.Version
+}
+
+// newCompatFilter returns the filter for the k6 version. Prerelease k6 versions are compared
+// as their release, otherwise they would only match constraints containing a prerelease.
+func newCompatFilter(k6Version string) (*compatFilter, error) {
+	version, err := semver.NewVersion(k6Version)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnknownK6, err)
+	}
+
+	release, err := version.SetPrerelease("")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnknownK6, err)
+	}
+
+	return &compatFilter{version: &release}, nil
+}
+
+// filter matches the extensions whose k6 version constraints are satisfied.
+// Extensions without constraints match, extensions with invalid constraints don't.
+func (c *compatFilter) filter(ext *extension) bool {
+	if c == nil || ext.Constraints == "" {
+		return true
+	}
+
+	constraints, err := semver.NewConstraint(ext.Constraints)
+	if err != nil {
+		return false
+	}
+
+	return constraints.Check(c.version)
+}
+
+// targetVersion is the k6 release given by the --k6-version flag, checked against the
+// version constraints instead of the running k6.
+type targetVersion string
+
+func (v *targetVersion) String() string {
+	if v == nil {
+		return ""
+	}
+
+	return string(*v)
+}
+
+func (v *targetVersion) Set(s string) error {
+	if _, err := semver.NewVersion(s); err != nil {
+		return fmt.Errorf("%w %q: %w", errInvalidK6, s, err)
+	}
+
+	*v = targetVersion(s)
+
+	return nil
+}
+
+func (v *targetVersion) Type() string {
+	return "version"
+}
+
+// versionConstraint is the semver constraint given by the --version-constraint flag,
+// e.g. >=v0.5.0, which at least one version of the listed extensions satisfies.
+type versionConstraint struct {
+	spec        string
+	constraints *semver.Constraints
+}
+
+func (v *versionConstraint) String() string {
+	if v == nil {
+		return ""
+	}
+
+	return v.spec
+}
+
+func (v *versionConstraint) Set(s string) error {
+	constraints, err := semver.NewConstraint(s)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", errInvalidVersions, s, err)
+	}
+
+	*v = versionConstraint{spec: s, constraints: constraints}
+
+	return nil
+}
+
+func (v *versionConstraint) Type() string {
+	return "constraint"
+}
+
+// best returns the highest version of the extension satisfying the constraint,
+// or an empty string if there is none. Invalid versions are skipped.
+func (v *versionConstraint) best(ext *extension) string {
+	var best *semver.Version
+
+	for _, s := range ext.Versions {
+		version, err := semver.NewVersion(s)
+		if err != nil || !v.constraints.Check(version) {
+			continue
+		}
+
+		if best == nil || version.GreaterThan(best) {
+			best = version
+		}
+	}
+
+	if best == nil {
+		return ""
+	}
+
+	return best.Original()
+}
+
+// filter matches the extensions having at least one version satisfying the constraint.
+func (v *versionConstraint) filter(ext *extension) bool {
+	if v == nil || v.constraints == nil {
+		return true
+	}
+
+	return v.best(ext) != ""
+}
+
+// pin returns copies of the extensions with the best matching version as the latest one,
+// so the output reports the version to use instead of the absolute latest.
+func (v *versionConstraint) pin(extensions []*extension) []*extension {
+	if v == nil || v.constraints == nil {
+		return extensions
+	}
+
+	pinned := make([]*extension, 0, len(extensions))
+
+	for _, ext := range extensions {
+		cp := *ext
+		cp.Latest = v.best(ext)
+		pinned = append(pinned, &cp)
+	}
+
+	return pinned
+}
+
+// cgoRequirement is the --cgo flag: when set, only the extensions whose cgo requirement
+// equals the value are listed, e.g. --cgo=false for static cross-builds.
+type cgoRequirement struct {
+	set   bool
+	value bool
+}
+
+func (c *cgoRequirement) String() string {
+	if c == nil || !c.set {
+		return ""
+	}
+
+	return strconv.FormatBool(c.value)
+}
+
+func (c *cgoRequirement) Set(s string) error {
+	value, err := strconv.ParseBool(s)
+	if err != nil {
+		return fmt.Errorf("%w: %q", errInvalidCgo, s)
+	}
+
+	*c = cgoRequirement{set: true, value: value}
+
+	return nil
+}
+
+func (c *cgoRequirement) Type() string {
+	return "bool"
+}
+
+func (c *cgoRequirement) filter(ext *extension) bool {
+	return c == nil || !c.set || ext.Cgo == c.value
+}
+
+// platform is the target platform given by the --os and --arch flags, either may be empty.
+type platform struct {
+	os   string
+	arch string
+}
+
+// filter matches the extensions supporting the platform. Extensions which don't
+// declare their platforms match any platform.
+func (p *platform) filter(ext *extension) bool {
+	if p == nil || (p.os == "" && p.arch == "") || len(ext.Platforms) == 0 {
+		return true
+	}
+
+	for _, supported := range ext.Platforms {
+		goos, goarch, _ := strings.Cut(supported, "/")
+
+		if (p.os == "" || strings.EqualFold(p.os, goos)) && (p.arch == "" || strings.EqualFold(p.arch, goarch)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortKey is a key of the --sort flag, in descending order when prefixed with -.
+type sortKey struct {
+	name string
+	desc bool
+}
+
+// sortOrder is the --sort flag, a list of sort keys, e.g. -stars,module.
+type sortOrder []sortKey
+
+// sortComparators compare two extensions by sort key, in ascending order.
+//
+//nolint:gochecknoglobals
+var sortComparators = map[string]func(a, b *extension) int{
+	"tier": func(a, b *extension) int {
+		tierA, tierB := normalizedTier(a), normalizedTier(b)
+
+		return cmp.Or(cmp.Compare(tierRank(tierA), tierRank(tierB)), strings.Compare(tierA, tierB))
+	},
+	"type": func(a, b *extension) int {
+		return strings.Compare(extensionType(a), extensionType(b))
+	},
+	"module": func(a, b *extension) int {
+		return strings.Compare(a.Module, b.Module)
+	},
+	"stars": func(a, b *extension) int {
+		return cmp.Compare(repoStars(a), repoStars(b))
+	},
+	"updated": func(a, b *extension) int {
+		return cmp.Compare(repoTimestamp(a), repoTimestamp(b))
+	},
+	"latest": func(a, b *extension) int {
+		return compareVersions(a.Latest, b.Latest)
+	},
+}
+
+// defaultSortOrder lists the official extensions first, then by type and module path.
+//
+//nolint:gochecknoglobals
+var defaultSortOrder = sortOrder{{name: "tier"}, {name: "type"}, {name: "module"}}
+
+// sortKeyNames returns the sorted names of the sort keys.
+func sortKeyNames() []string {
+	return slices.Sorted(maps.Keys(sortComparators))
+}
+
+func (o *sortOrder) String() string {
+	if o == nil {
+		return ""
+	}
+
+	keys := make([]string, 0, len(*o))
+
+	for _, key := range *o {
+		if key.desc {
+			keys = append(keys, "-"+key.name)
+		} else {
+			keys = append(keys, key.name)
+		}
+	}
+
+	return strings.Join(keys, ",")
+}
+
+// Set accepts a single sort key or a comma-separated list. Repeated flags accumulate.
+func (o *sortOrder) Set(s string) error {
+	for name := range strings.SplitSeq(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		key := sortKey{name: strings.TrimPrefix(name, "-"), desc: strings.HasPrefix(name, "-")}
+
+		if _, found := sortComparators[key.name]; !found {
+			return fmt.Errorf("%w: %s (allowed values are %s)", errInvalidSort, key.name, strings.Join(sortKeyNames(), ", "))
+		}
+
+		*o = append(*o, key)
+	}
+
+	return nil
+}
+
+func (o *sortOrder) Type() string {
+	return "keys"
+}
+
+// compare compares two extensions by the sort keys in turn, then by module path.
+func (o sortOrder) compare(a, b *extension) int {
+	for _, key := range o {
+		c := sortComparators[key.name](a, b)
+		if key.desc {
+			c = -c
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return strings.Compare(a.Module, b.Module)
+}
+
+// columnList is the list of table columns given by the --columns flag, in display order.
+type columnList []string
+
+func (c *columnList) String() string {
+	if c == nil {
+		return ""
+	}
+
+	return strings.Join(*c, ",")
+}
+
+// Set accepts a single column or a comma-separated list. Repeated flags accumulate.
+func (c *columnList) Set(s string) error {
+	for name := range strings.SplitSeq(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		if _, found := tableColumns[name]; !found {
+			return fmt.Errorf("%w: %s (allowed values are %s)", errInvalidColumn, name, strings.Join(columnNames(), ", "))
+		}
+
+		if !slices.Contains(*c, name) {
+			*c = append(*c, name)
+		}
+	}
+
+	return nil
+}
+
+func (c *columnList) Type() string {
+	return "columns"
+}
+
+func (g *groupBy) String() string {
+	if g == nil {
+		return ""
+	}
+
+	return string(*g)
+}
+
+func (g *groupBy) Set(s string) error {
+	switch groupBy(s) {
+	case groupByTier, groupByType, groupByOwner:
+		*g = groupBy(s)
+
+		return nil
+	default:
+		return errInvalidGroupBy
+	}
+}
+
+func (g *groupBy) Type() string {
+	return "key"
+}
+
+// label returns the section heading of the group of the extension, e.g. Official.
+func (g groupBy) label(ext *extension) string {
+	switch g {
+	case groupByTier:
+		return extensionTier(ext)
+	case groupByType:
+		if typ := extensionType(ext); typ != "" {
+			return typ
+		}
+	case groupByOwner:
+		if owners := extensionOwners(ext); len(owners) > 0 {
+			return owners[0]
+		}
+	}
+
+	return "Other"
+}
+
+// compare orders the groups: tiers and types like --sort, owners alphabetically.
+func (g groupBy) compare(a, b *extension) int {
+	if compare, found := sortComparators[string(g)]; found {
+		return compare(a, b)
+	}
+
+	labelA, labelB := g.label(a), g.label(b)
+
+	return cmp.Or(strings.Compare(strings.ToLower(labelA), strings.ToLower(labelB)), strings.Compare(labelA, labelB))
+}
+
+// includeDeprecated is the --include-deprecated flag. Deprecated extensions and
+// extensions with an archived repository are hidden unless it is set.
+type includeDeprecated bool
+
+func (i *includeDeprecated) filter(ext *extension) bool {
+	return (i != nil && bool(*i)) || !isDeprecated(ext)
+}
+
+// extensionOwners returns the possible owners of the extension: the repository owner
+// and the second segment of the module path.
+func extensionOwners(ext *extension) []string {
+	var candidates []string
+
+	if ext.Repo != nil && ext.Repo.Owner != "" {
+		candidates = append(candidates, ext.Repo.Owner)
+	}
+
+	if parts := strings.Split(ext.Module, "/"); len(parts) > 1 {
+		candidates = append(candidates, parts[1])
+	}
+
+	return candidates
+}
+
+// matchPattern is a regular expression matched against the module path and the description.
+type matchPattern struct {
+	re *regexp.Regexp
+}
+
+func (m *matchPattern) String() string {
+	if m == nil || m.re == nil {
+		return ""
+	}
+
+	return m.re.String()
+}
+
+func (m *matchPattern) Set(s string) error {
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidMatch, err)
+	}
+
+	m.re = re
+
+	return nil
+}
+
+func (m *matchPattern) Type() string {
+	return "regexp"
+}
+
+// ignoreCase makes the pattern case-insensitive.
+func (m *matchPattern) ignoreCase() {
+	if m.re != nil {
+		m.re = regexp.MustCompile("(?i)" + m.re.String())
+	}
+}
+
+func (m *matchPattern) filter(ext *extension) bool {
+	if m == nil || m.re == nil {
 		return true
 	}
 
-	return value
+	return m.re.MatchString(ext.Module) || m.re.MatchString(ext.Description)
 }
 
 type options struct {
-	json     bool
-	detailed bool
-	brief    bool
-	notrunc  bool
-	tier     tier
-	kind     kind
-	gs       *state.GlobalState
+	json          bool
+	count         bool
+	detailed      bool
+	plain         bool
+	brief         bool
+	notrunc       bool
+	longValues    bool
+	ellipsis      ellipsis
+	delimiter     delimiter
+	numbers       numberStyle
+	groupBy       groupBy
+	columns       columnList
+	strict        bool
+	prerelease    bool
+	utc           bool
+	relativeDates bool
+	absoluteDates bool
+	tiers         tierSet
+	kinds         kindSet
+	format        format
+	emit          emitTarget
+	bundles       []string
+	notes         string
+	silentSuccess bool
+	renderGolden  string
+	porcelain     porcelainVersion
+	jsonMeta      bool
+	jsonSchema    bool
+	terms         []string
+	failOn        failOn
+	failEmpty     bool
+	starred       bool
+	match         matchPattern
+	outputDir     string
+	caseSensitive bool
+	categories    categories
+	owners        owners
+	repoHosts     repoHosts
+	subcommands   subcommandNames
+	minStars      minStars
+	licenses      licenses
+	updatedSince  updatedSince
+	deprecated    includeDeprecated
+	compat        bool
+	annotate      bool
+	k6Version     targetVersion
+	versions      versionConstraint
+	cgo           cgoRequirement
+	platform      platform
+	expr          exprFilter
+	jq            jqQuery
+	sort          sortOrder
+	maxVersions   int
+	limit         int
+	offset        int
+	catalog       string
+	traceFile     string
+	reuseFetch    string
+	noNetwork     bool
+	recorder      *harRecorder
+	summary       *runSummary
+	gs            *state.GlobalState
 }
@@ -2,6 +2,7 @@ package explore
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -34,10 +35,10 @@ func TestKindSet(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name:    "invalid type",
-			input:   "invalid",
-			want:    "",
-			wantErr: true,
+			name:    "type unknown to this release",
+			input:   "secret-source",
+			want:    kind("secret-source"),
+			wantErr: false,
 		},
 		{
 			name:    "empty string",
@@ -196,10 +197,10 @@ func TestTierSet(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name:    "invalid tier",
-			input:   "invalid",
-			want:    "",
-			wantErr: true,
+			name:    "tier unknown to this release",
+			input:   "partner",
+			want:    tier("partner"),
+			wantErr: false,
 		},
 		{
 			name:    "empty string",
@@ -305,6 +306,12 @@ func TestTierFilter(t *testing.T) {
 			ext:  &extension{Tier: "official"},
 			want: false,
 		},
+		{
+			name: "partner matches partner",
+			tier: func() *tier { t := tier("partner"); return &t }(),
+			ext:  &extension{Tier: "partner"},
+			want: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -318,3 +325,682 @@ func TestTierFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestTierValidate(t *testing.T) {
+	t.Parallel()
+
+	known := []string{"community", "official", "partner"}
+
+	var empty tier
+
+	require.NoError(t, empty.validate(known))
+
+	partner := tier("partner")
+	require.NoError(t, partner.validate(known))
+
+	unknown := tier("unknown")
+	err := unknown.validate(known)
+	require.ErrorIs(t, err, errInvalidTier)
+	require.ErrorContains(t, err, "community, official, partner")
+}
+
+func TestFormatSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    format
+		wantErr bool
+	}{
+		{
+			name:    "valid table",
+			input:   "table",
+			want:    formatTable,
+			wantErr: false,
+		},
+		{
+			name:    "valid json",
+			input:   "json",
+			want:    formatJSON,
+			wantErr: false,
+		},
+		{
+			name:    "valid prom",
+			input:   "prom",
+			want:    formatProm,
+			wantErr: false,
+		},
+		{
+			name:    "valid csv",
+			input:   "csv",
+			want:    formatCSV,
+			wantErr: false,
+		},
+		{
+			name:    "valid tsv",
+			input:   "tsv",
+			want:    formatTSV,
+			wantErr: false,
+		},
+		{
+			name:    "invalid format",
+			input:   "invalid",
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var f format
+
+			err := f.Set(tt.input)
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, f)
+			}
+		})
+	}
+}
+
+func TestEmitTargetSet(t *testing.T) {
+	t.Parallel()
+
+	var e emitTarget
+
+	require.NoError(t, e.Set("go-get"))
+	require.Equal(t, emitGoGetTarget, e)
+	require.ErrorIs(t, e.Set("invalid"), errInvalidEmit)
+}
+
+func TestDelimiterSet(t *testing.T) {
+	t.Parallel()
+
+	var d delimiter
+
+	require.Equal(t, ',', d.comma())
+
+	require.NoError(t, d.Set(";"))
+	require.Equal(t, ';', d.comma())
+	require.Equal(t, ";", d.String())
+
+	require.NoError(t, d.Set("\u00a7"))
+	require.Equal(t, '\u00a7', d.comma())
+
+	for _, invalid := range []string{"", ";;", `"`, "\n", "\r"} {
+		require.ErrorIs(t, d.Set(invalid), errInvalidDelim, invalid)
+	}
+}
+
+func TestMatchPatternFilter(t *testing.T) {
+	t.Parallel()
+
+	ext := &extension{
+		Module:      "github.com/grafana/xk6-sql",
+		Description: "Load-test SQL Servers",
+	}
+
+	tests := []struct {
+		name          string
+		pattern       string
+		caseSensitive bool
+		want          bool
+	}{
+		{name: "module", pattern: `^github\.com/grafana/.*sql$`, want: true},
+		{name: "description", pattern: `sql servers`, want: true},
+		{name: "case-sensitive", pattern: `sql servers`, caseSensitive: true, want: false},
+		{name: "case-sensitive match", pattern: `SQL Servers`, caseSensitive: true, want: true},
+		{name: "no match", pattern: `kafka`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var m matchPattern
+
+			require.NoError(t, m.Set(tt.pattern))
+			require.Equal(t, tt.pattern, m.String())
+
+			if !tt.caseSensitive {
+				m.ignoreCase()
+			}
+
+			require.Equal(t, tt.want, m.filter(ext))
+		})
+	}
+
+	var empty matchPattern
+
+	empty.ignoreCase()
+
+	require.True(t, empty.filter(ext))
+	require.ErrorIs(t, empty.Set("("), errInvalidMatch)
+}
+
+func TestCategoriesFilter(t *testing.T) {
+	t.Parallel()
+
+	ext := &extension{Categories: []string{"messaging", "kubernetes"}}
+
+	tests := []struct {
+		name   string
+		values []string
+		want   bool
+	}{
+		{name: "no filter", values: nil, want: true},
+		{name: "single", values: []string{"messaging"}, want: true},
+		{name: "case-insensitive", values: []string{"Kubernetes"}, want: true},
+		{name: "any of repeated", values: []string{"browser", "messaging"}, want: true},
+		{name: "list", values: []string{"browser,kubernetes"}, want: true},
+		{name: "no match", values: []string{"browser"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var c categories
+
+			for _, value := range tt.values {
+				require.NoError(t, c.Set(value))
+			}
+
+			require.Equal(t, tt.want, c.filter(ext))
+		})
+	}
+
+	var c categories
+
+	require.NoError(t, c.Set("browser"))
+	require.False(t, c.filter(&extension{}))
+}
+
+func TestOwnersFilter(t *testing.T) {
+	t.Parallel()
+
+	grafana := &extension{Module: "github.com/grafana/xk6-faker"}
+	vanity := &extension{Module: "go.example.com/xk6-vanity", Repo: &repository{Owner: "Example"}}
+	thirdParty := &extension{Module: "github.com/mostafa/xk6-kafka"}
+
+	tests := []struct {
+		name   string
+		values []string
+		want   []bool // grafana, vanity, thirdParty
+	}{
+		{name: "no filter", values: nil, want: []bool{true, true, true}},
+		{name: "module owner", values: []string{"grafana"}, want: []bool{true, false, false}},
+		{name: "repository owner", values: []string{"example"}, want: []bool{false, true, false}},
+		{name: "any of", values: []string{"grafana,mostafa"}, want: []bool{true, false, true}},
+		{name: "exclusion", values: []string{"!Grafana"}, want: []bool{false, true, true}},
+		{name: "inclusion and exclusion", values: []string{"grafana", "!grafana"}, want: []bool{false, false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var o owners
+
+			for _, value := range tt.values {
+				require.NoError(t, o.Set(value))
+			}
+
+			require.Equal(t, tt.want, []bool{o.filter(grafana), o.filter(vanity), o.filter(thirdParty)})
+		})
+	}
+}
+
+func TestRepoHostsFilter(t *testing.T) {
+	t.Parallel()
+
+	github := &extension{Module: "github.com/grafana/xk6-faker"}
+	gitlab := &extension{Module: "gitlab.com/example/xk6-example"}
+
+	tests := []struct {
+		name   string
+		values []string
+		want   []bool // github, gitlab
+	}{
+		{name: "no filter", values: nil, want: []bool{true, true}},
+		{name: "single", values: []string{"github.com"}, want: []bool{true, false}},
+		{name: "case-insensitive", values: []string{"GitLab.com"}, want: []bool{false, true}},
+		{name: "list", values: []string{"github.com,gitlab.com"}, want: []bool{true, true}},
+		{name: "prefix is not a host", values: []string{"github"}, want: []bool{false, false}},
+		{name: "URL", values: []string{"https://GitLab.com/"}, want: []bool{false, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var h repoHosts
+
+			for _, value := range tt.values {
+				require.NoError(t, h.Set(value))
+			}
+
+			require.Equal(t, tt.want, []bool{h.filter(github), h.filter(gitlab)})
+		})
+	}
+}
+
+func TestSubcommandNamesFilter(t *testing.T) {
+	t.Parallel()
+
+	dashboard := &extension{Subcommands: []string{"dashboard"}}
+	faker := &extension{Imports: []string{"k6/x/faker"}}
+
+	var empty subcommandNames
+
+	require.True(t, empty.filter(dashboard))
+	require.True(t, empty.filter(faker))
+
+	var names subcommandNames
+
+	require.NoError(t, names.Set("httpbin, Dashboard"))
+	require.Equal(t, "httpbin,Dashboard", names.String())
+	require.True(t, names.filter(dashboard))
+	require.False(t, names.filter(faker))
+
+	var other subcommandNames
+
+	require.NoError(t, other.Set("dash"))
+	require.False(t, other.filter(dashboard))
+}
+
+func TestKindSetFilter(t *testing.T) {
+	t.Parallel()
+
+	js := &extension{Imports: []string{"k6/x/faker"}}
+	out := &extension{Outputs: []string{"influxdb"}}
+	sub := &extension{Subcommands: []string{"dashboard"}, Capabilities: map[string][]string{"secret-source": {"vault"}}}
+
+	tests := []struct {
+		name    string
+		values  []string
+		want    []bool // js, out, sub
+		wantErr bool
+	}{
+		{name: "no filter", values: nil, want: []bool{true, true, true}},
+		{name: "single", values: []string{"javascript"}, want: []bool{true, false, false}},
+		{name: "repeated", values: []string{"javascript", "output"}, want: []bool{true, true, false}},
+		{name: "list", values: []string{"output,subcommand"}, want: []bool{false, true, true}},
+		{name: "capability", values: []string{"secret-source"}, want: []bool{false, false, true}},
+		{name: "invalid", values: []string{"javascript,"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var ks kindSet
+
+			for _, value := range tt.values {
+				err := ks.Set(value)
+				if tt.wantErr {
+					require.ErrorIs(t, err, errInvalidKind)
+
+					return
+				}
+
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tt.want, []bool{ks.filter(js), ks.filter(out), ks.filter(sub)})
+		})
+	}
+
+	var ks kindSet
+
+	require.NoError(t, ks.Set("javascript"))
+	require.NoError(t, ks.Set("output,javascript"))
+	require.Equal(t, "javascript,output", ks.String())
+
+	require.NoError(t, ks.Set("secret-source"))
+	require.NoError(t, ks.validate([]string{"javascript", "output", "secret-source"}))
+	require.ErrorIs(t, ks.validate([]string{"javascript", "output"}), errInvalidKind)
+}
+
+func TestTierSetFilter(t *testing.T) {
+	t.Parallel()
+
+	official := &extension{Tier: "official"}
+	community := &extension{Tier: "community"}
+	partner := &extension{Tier: "partner"}
+
+	tests := []struct {
+		name   string
+		values []string
+		want   []bool // official, community, partner
+	}{
+		{name: "no filter", values: nil, want: []bool{true, true, true}},
+		{name: "single", values: []string{"official"}, want: []bool{true, false, false}},
+		{name: "repeated", values: []string{"official", "partner"}, want: []bool{true, false, true}},
+		{name: "list", values: []string{"community, partner"}, want: []bool{false, true, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var ts tierSet
+
+			for _, value := range tt.values {
+				require.NoError(t, ts.Set(value))
+			}
+
+			require.Equal(t, tt.want, []bool{ts.filter(official), ts.filter(community), ts.filter(partner)})
+		})
+	}
+
+	var ts tierSet
+
+	require.ErrorIs(t, ts.Set("official,"), errInvalidTier)
+
+	ts = nil
+
+	require.NoError(t, ts.Set("official,partner"))
+	require.Equal(t, "official,partner", ts.String())
+	require.NoError(t, ts.validate([]string{"community", "official", "partner"}))
+	require.ErrorIs(t, ts.validate([]string{"community", "official"}), errInvalidTier)
+}
+
+func TestMinStarsFilter(t *testing.T) {
+	t.Parallel()
+
+	popular := &extension{Repo: &repository{Stars: 120}}
+	hobby := &extension{Repo: &repository{Stars: 3}}
+	unknown := &extension{}
+
+	tests := []struct {
+		name  string
+		value string
+		want  []bool // popular, hobby, unknown
+	}{
+		{name: "no filter", value: "0", want: []bool{true, true, true}},
+		{name: "threshold", value: "20", want: []bool{true, false, false}},
+		{name: "exact", value: "3", want: []bool{true, true, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var m minStars
+
+			require.NoError(t, m.Set(tt.value))
+			require.Equal(t, tt.value, m.String())
+			require.Equal(t, tt.want, []bool{m.filter(popular), m.filter(hobby), m.filter(unknown)})
+		})
+	}
+
+	var m minStars
+
+	require.ErrorIs(t, m.Set("-1"), errInvalidStars)
+	require.ErrorIs(t, m.Set("many"), errInvalidStars)
+}
+
+func TestLicensesFilter(t *testing.T) {
+	t.Parallel()
+
+	apache := &extension{Repo: &repository{License: "Apache-2.0"}}
+	agpl := &extension{Repo: &repository{License: "AGPL-3.0"}}
+	unknown := &extension{Repo: &repository{URL: "https://github.com/example/xk6-unknown"}}
+
+	tests := []struct {
+		name   string
+		values []string
+		want   []bool // apache, agpl, unknown
+	}{
+		{name: "no filter", values: nil, want: []bool{true, true, true}},
+		{name: "single", values: []string{"apache-2.0"}, want: []bool{true, false, false}},
+		{name: "list", values: []string{"Apache-2.0, MIT"}, want: []bool{true, false, false}},
+		{name: "repeated", values: []string{"MIT", "AGPL-3.0"}, want: []bool{false, true, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var l licenses
+
+			for _, value := range tt.values {
+				require.NoError(t, l.Set(value))
+			}
+
+			require.Equal(t, tt.want, []bool{l.filter(apache), l.filter(agpl), l.filter(unknown)})
+		})
+	}
+}
+
+func TestUpdatedSinceFilter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	recent := &extension{Repo: &repository{Timestamp: float64(now.Add(-10 * 24 * time.Hour).Unix())}}
+	old := &extension{Repo: &repository{Timestamp: float64(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC).Unix())}}
+	unknown := &extension{Repo: &repository{URL: "https://github.com/example/xk6-unknown"}}
+
+	tests := []struct {
+		name  string
+		value string
+		want  []bool // recent, old, unknown
+	}{
+		{name: "no filter", value: "", want: []bool{true, true, true}},
+		{name: "relative", value: "90d", want: []bool{true, false, false}},
+		{name: "relative too short", value: "1w", want: []bool{false, false, false}},
+		{name: "date", value: "2023-01-01", want: []bool{true, true, false}},
+		{name: "later date", value: "2024-01-01", want: []bool{true, false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var u updatedSince
+
+			if tt.value != "" {
+				require.NoError(t, u.Set(tt.value))
+			}
+
+			require.Equal(t, tt.value, u.String())
+			require.Equal(t, tt.want, []bool{u.filter(recent), u.filter(old), u.filter(unknown)})
+		})
+	}
+
+	var u updatedSince
+
+	require.ErrorIs(t, u.Set("yesterday"), errInvalidSince)
+	require.ErrorIs(t, u.Set("2024-13-01"), errInvalidSince)
+	require.ErrorIs(t, u.Set("0d"), errInvalidSince)
+}
+
+func TestIncludeDeprecatedFilter(t *testing.T) {
+	t.Parallel()
+
+	maintained := &extension{Repo: &repository{URL: "https://github.com/grafana/xk6-faker"}}
+	archived := &extension{Repo: &repository{Archived: true}}
+	deprecated := &extension{Deprecated: true}
+
+	hide := includeDeprecated(false)
+	include := includeDeprecated(true)
+
+	require.Equal(t, []bool{true, false, false}, []bool{hide.filter(maintained), hide.filter(archived), hide.filter(deprecated)})
+	require.Equal(t, []bool{true, true, true}, []bool{include.filter(maintained), include.filter(archived), include.filter(deprecated)})
+}
+
+func TestCompatFilter(t *testing.T) {
+	t.Parallel()
+
+	unconstrained := &extension{}
+	v2 := &extension{Constraints: ">=v2.0.0"}
+	v1 := &extension{Constraints: "<v2.0.0"}
+	invalid := &extension{Constraints: "not a constraint"}
+
+	compat, err := newCompatFilter("v2.1.0-rc1")
+	require.NoError(t, err)
+	require.Equal(t,
+		[]bool{true, true, false, false},
+		[]bool{compat.filter(unconstrained), compat.filter(v2), compat.filter(v1), compat.filter(invalid)},
+	)
+
+	_, err = newCompatFilter("")
+	require.ErrorIs(t, err, errUnknownK6)
+}
+
+func TestVersionConstraint(t *testing.T) {
+	t.Parallel()
+
+	faker := &extension{Module: "xk6-faker", Versions: []string{"v0.3.0", "v0.4.4", "v1.0.0", "invalid"}, Latest: "v1.0.0"}
+	sql := &extension{Module: "xk6-sql", Versions: []string{"v1.0.0", "v1.1.0"}, Latest: "v1.1.0"}
+
+	var versions versionConstraint
+
+	require.True(t, versions.filter(faker))
+	require.Equal(t, []*extension{faker}, versions.pin([]*extension{faker}))
+
+	require.NoError(t, versions.Set("<v1.0.0"))
+	require.Equal(t, "<v1.0.0", versions.String())
+	require.True(t, versions.filter(faker))
+	require.False(t, versions.filter(sql))
+
+	pinned := versions.pin([]*extension{faker})
+	require.Equal(t, "v0.4.4", pinned[0].Latest)
+	require.Equal(t, "v1.0.0", faker.Latest, "the catalog entry is left unchanged")
+
+	require.ErrorIs(t, versions.Set("not a constraint"), errInvalidVersions)
+}
+
+func TestCgoRequirement(t *testing.T) {
+	t.Parallel()
+
+	pure := &extension{Module: "github.com/grafana/xk6-faker"}
+	native := &extension{Module: "github.com/example/xk6-sqlite", Cgo: true}
+
+	var cgo cgoRequirement
+
+	require.Empty(t, cgo.String())
+	require.True(t, cgo.filter(pure))
+	require.True(t, cgo.filter(native))
+
+	require.NoError(t, cgo.Set("false"))
+	require.Equal(t, "false", cgo.String())
+	require.True(t, cgo.filter(pure))
+	require.False(t, cgo.filter(native))
+
+	require.NoError(t, cgo.Set("true"))
+	require.False(t, cgo.filter(pure))
+	require.True(t, cgo.filter(native))
+
+	require.ErrorIs(t, cgo.Set("maybe"), errInvalidCgo)
+}
+
+func TestPlatformFilter(t *testing.T) {
+	t.Parallel()
+
+	portable := &extension{Module: "github.com/grafana/xk6-faker"}
+	linux := &extension{Module: "github.com/example/xk6-ebpf", Platforms: []string{"linux/amd64", "linux/arm64"}}
+	windows := &extension{Module: "github.com/example/xk6-wmi", Platforms: []string{"windows/amd64"}}
+
+	tests := []struct {
+		name     string
+		platform platform
+		want     []bool
+	}{
+		{name: "unset", platform: platform{}, want: []bool{true, true, true}},
+		{name: "os", platform: platform{os: "linux"}, want: []bool{true, true, false}},
+		{name: "arch", platform: platform{arch: "amd64"}, want: []bool{true, true, true}},
+		{name: "os and arch", platform: platform{os: "windows", arch: "arm64"}, want: []bool{true, false, false}},
+		{name: "case", platform: platform{os: "Linux", arch: "ARM64"}, want: []bool{true, true, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, []bool{
+				tt.platform.filter(portable), tt.platform.filter(linux), tt.platform.filter(windows),
+			})
+		})
+	}
+}
+
+func TestSortOrder(t *testing.T) {
+	t.Parallel()
+
+	faker := &extension{Module: "github.com/grafana/xk6-faker", Tier: "official", Repo: &repository{Stars: 50}}
+	sql := &extension{Module: "github.com/grafana/xk6-sql", Latest: "v1.10.0", Repo: &repository{Stars: 200}}
+	kafka := &extension{Module: "github.com/mostafa/xk6-kafka", Latest: "v1.9.0", Repo: &repository{Stars: 200}}
+
+	sorted := func(order sortOrder) []string {
+		extensions := []*extension{kafka, sql, faker}
+		sortExtensions(extensions, order)
+
+		modules := make([]string, 0, len(extensions))
+		for _, ext := range extensions {
+			modules = append(modules, ext.Module)
+		}
+
+		return modules
+	}
+
+	require.Equal(t, []string{faker.Module, sql.Module, kafka.Module}, sorted(nil))
+
+	var order sortOrder
+
+	require.NoError(t, order.Set("-stars, Module"))
+	require.Equal(t, "-stars,module", order.String())
+	require.Equal(t, []string{sql.Module, kafka.Module, faker.Module}, sorted(order))
+
+	require.NoError(t, order.Set("-latest"))
+	require.Equal(t, sortOrder{{name: "stars", desc: true}, {name: "module"}, {name: "latest", desc: true}}, order)
+	require.Equal(t, []string{faker.Module, kafka.Module, sql.Module}, sorted(sortOrder{{name: "latest"}}))
+
+	require.ErrorIs(t, order.Set("popularity"), errInvalidSort)
+}
+
+func TestTargetVersion(t *testing.T) {
+	t.Parallel()
+
+	var version targetVersion
+
+	require.NoError(t, version.Set("v1.2.0"))
+	require.Equal(t, "v1.2.0", version.String())
+
+	require.ErrorIs(t, version.Set("latest"), errInvalidK6)
+	require.Equal(t, "v1.2.0", version.String())
+}
+
+func TestPageExtensions(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := &extension{Module: "a"}, &extension{Module: "b"}, &extension{Module: "c"}
+
+	tests := []struct {
+		name          string
+		offset, limit int
+		want          []*extension
+	}{
+		{name: "all", want: []*extension{a, b, c}},
+		{name: "limit", limit: 2, want: []*extension{a, b}},
+		{name: "offset", offset: 1, want: []*extension{b, c}},
+		{name: "page", offset: 1, limit: 1, want: []*extension{b}},
+		{name: "limit beyond end", offset: 2, limit: 5, want: []*extension{c}},
+		{name: "offset beyond end", offset: 5, limit: 1, want: []*extension{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, pageExtensions([]*extension{a, b, c}, tt.offset, tt.limit))
+		})
+	}
+}
@@ -1,50 +1,261 @@
 package explore
 
 import (
+	"bytes"
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
 	"text/tabwriter"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/fatih/color"
 	"github.com/muesli/reflow/indent"
 	"github.com/muesli/reflow/wordwrap"
 	"go.k6.io/k6/v2/cmd/state"
 	"golang.org/x/term"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
 )
 
 const (
-	normalHeader = "MODULE\tLATEST\tTYPE\tTIER\tDESCRIPTION\n"
-	briefHeader  = "MODULE\tDESCRIPTION\n"
 	typeColWidth = 4
 	tierColWidth = 4
 	minDescWidth = 20
 
 	columnPadding = 2
 
-	normalPaddings = 10 // total padding for all columns
-	briefPaddings  = 4  // total padding for all columns in brief mode
-
 	defaultTerminalWidth = 120 // default width when not in a terminal
 
-	dots    = "..."
-	dotsLen = len(dots)
-
 	listMargin = 2
+
+	// localTimeLayout formats the times in the local time zone, see dateFormat.
+	localTimeLayout = "2006-01-02 15:04 MST"
+
+	// deprecatedBadge marks the deprecated extensions listed with --include-deprecated.
+	deprecatedBadge = "[deprecated]"
 )
 
-func outputJSON(gs *state.GlobalState, extensions []*extension) error {
+func outputJSON(gs *state.GlobalState, v any) error {
 	encoder := json.NewEncoder(gs.Stdout)
 	encoder.SetIndent("", "  ")
 
-	return encoder.Encode(extensions)
+	return encoder.Encode(v)
+}
+
+// outputNDJSON writes each value of the list as JSON on its own line (newline delimited JSON),
+// for streaming consumers such as log pipelines, DuckDB or jq -c.
+func outputNDJSON[T any](gs *state.GlobalState, list []T) error {
+	encoder := json.NewEncoder(gs.Stdout)
+
+	for _, v := range list {
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jsonSchemaVersion is the version of the JSON output, increased on the changes which may
+// break its consumers: removed or renamed properties, changed meanings or order.
+const jsonSchemaVersion = 1
+
+// jsonMeta is the JSON output with --json-meta: the extensions wrapped in an object telling
+// where they came from, e.g. for auditing the data used by automation, and the version of the
+// format, so that consumers detect the incompatible changes.
+type jsonMeta struct {
+	SchemaVersion int `json:"schemaVersion"`
+	// CatalogURL is the URL of the registry catalog, or the --catalog snapshot.
+	CatalogURL string    `json:"catalogURL"`
+	FetchedAt  time.Time `json:"fetchedAt"`
+	// CacheHit is true when the catalog was reused from a previous run, see --reuse-fetch.
+	CacheHit bool `json:"cacheHit"`
+	// TotalCount is the number of extensions of the catalog, FilteredCount the number listed.
+	TotalCount    int              `json:"totalCount"`
+	FilteredCount int              `json:"filteredCount"`
+	Extensions    []*extensionJSON `json:"extensions"`
+}
+
+func newJSONMeta(summary *runSummary, fetchedAt time.Time, extensions []*extensionJSON) *jsonMeta {
+	meta := &jsonMeta{SchemaVersion: jsonSchemaVersion, FetchedAt: fetchedAt, FilteredCount: len(extensions), Extensions: extensions}
+
+	if summary != nil {
+		meta.CatalogURL, meta.CacheHit, meta.TotalCount = summary.url, summary.cache == cacheHit, summary.total
+	}
+
+	return meta
+}
+
+// ndjsonLine is a line of the ndjson format: an extension with the version of the format,
+// as the stream has no envelope to carry it.
+type ndjsonLine struct {
+	SchemaVersion int `json:"schemaVersion"`
+	*extensionJSON
+}
+
+func toNDJSONLines(extensions []*extensionJSON) []*ndjsonLine {
+	lines := make([]*ndjsonLine, 0, len(extensions))
+
+	for _, ext := range extensions {
+		lines = append(lines, &ndjsonLine{SchemaVersion: jsonSchemaVersion, extensionJSON: ext})
+	}
+
+	return lines
+}
+
+// extensionJSON is the JSON representation of an extension in the output, extended with
+// computed fields so consumers don't have to derive them. The properties are written in
+// the order of the fields, which is part of the output format: changing it, or the meaning
+// of a property, requires increasing jsonSchemaVersion.
+type extensionJSON struct {
+	Module string `json:"module"`
+	// Tier is the normalized tier, lowercase and community when missing.
+	Tier string `json:"tier"`
+	// Type is the main extension type, the first of Kinds, as in the TYPE column of the table.
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Latest      string `json:"latest,omitempty"`
+	// LatestStable is the highest version which is not a prerelease.
+	LatestStable string `json:"latestStable"`
+	// LatestPrerelease is the highest prerelease newer than LatestStable.
+	LatestPrerelease string   `json:"latestPrerelease"`
+	Versions         []string `json:"versions,omitempty"`
+	// Kinds lists the extension types: javascript, output, subcommand, then the keys of
+	// Capabilities, e.g. secret-source. The set is open, new extension points add kinds.
+	Kinds        []string            `json:"kinds"`
+	Imports      []string            `json:"imports,omitempty"`
+	Outputs      []string            `json:"outputs,omitempty"`
+	Subcommands  []string            `json:"subcommands,omitempty"`
+	Capabilities map[string][]string `json:"capabilities,omitempty"`
+	Categories   []string            `json:"categories,omitempty"`
+	Products     []string            `json:"products,omitempty"`
+	Constraints  string              `json:"constraints,omitempty"`
+	Repo         *repository         `json:"repo,omitempty"`
+	Notes        string              `json:"notes,omitempty"`
+	Platforms    []string            `json:"platforms,omitempty"`
+	Cgo          bool                `json:"cgo,omitempty"`
+	// Deprecated is true when the extension is deprecated or its repository archived.
+	Deprecated  bool         `json:"deprecated"`
+	Deprecation *deprecation `json:"deprecation,omitempty"`
+}
+
+func toJSON(ext *extension) *extensionJSON {
+	kinds := extensionKinds(ext)
+
+	kind := ""
+	if len(kinds) > 0 {
+		kind = kinds[0]
+	}
+
+	return &extensionJSON{
+		Module:           ext.Module,
+		Tier:             normalizedTier(ext),
+		Type:             kind,
+		Description:      ext.Description,
+		Latest:           ext.Latest,
+		LatestStable:     findLatestStable(ext.Versions),
+		LatestPrerelease: findLatestPrerelease(ext.Versions),
+		Versions:         ext.Versions,
+		Kinds:            kinds,
+		Imports:          ext.Imports,
+		Outputs:          ext.Outputs,
+		Subcommands:      ext.Subcommands,
+		Capabilities:     ext.Capabilities,
+		Categories:       ext.Categories,
+		Products:         ext.Products,
+		Constraints:      ext.Constraints,
+		Repo:             ext.Repo,
+		Notes:            ext.Notes,
+		Platforms:        ext.Platforms,
+		Cgo:              ext.Cgo,
+		Deprecated:       isDeprecated(ext),
+		Deprecation:      ext.Deprecation,
+	}
+}
+
+func toJSONList(extensions []*extension) []*extensionJSON {
+	list := make([]*extensionJSON, 0, len(extensions))
+	for _, ext := range extensions {
+		list = append(list, toJSON(ext))
+	}
+
+	return list
+}
+
+// trimVersions keeps only the newest n versions of the listed extensions, all if n is 0.
+// The computed fields still reflect all the versions.
+func trimVersions(list []*extensionJSON, n int) []*extensionJSON {
+	if n <= 0 {
+		return list
+	}
+
+	for _, item := range list {
+		if len(item.Versions) <= n {
+			continue
+		}
+
+		item.Versions = sortVersions(item.Versions)[:n]
+	}
+
+	return list
 }
 
-func outputDetailed(gs *state.GlobalState, extensions []*extension) error {
+// extensionKinds returns the types of the extension, an extension may have several.
+// The built-in types come first, followed by the other capabilities in alphabetical order.
+func extensionKinds(ext *extension) []string {
+	kinds := make([]string, 0, len(kindValues)+len(ext.Capabilities))
+
+	for _, value := range kindValues {
+		k := kind(value)
+		if k.filter(ext) {
+			kinds = append(kinds, value)
+		}
+	}
+
+	others := make([]string, 0, len(ext.Capabilities))
+
+	for key, names := range ext.Capabilities {
+		if len(names) > 0 {
+			others = append(others, key)
+		}
+	}
+
+	slices.Sort(others)
+
+	return append(kinds, others...)
+}
+
+// description returns the description of the extension, prefixed with a badge if deprecated.
+func description(ext *extension) string {
+	if isDeprecated(ext) {
+		return strings.TrimSpace(deprecatedBadge + " " + ext.Description)
+	}
+
+	return ext.Description
+}
+
+func normalizedTier(ext *extension) string {
+	tier := strings.ToLower(strings.TrimSpace(ext.Tier))
+	if tier == "" {
+		return string(tierCommunity)
+	}
+
+	return tier
+}
+
+func outputDetailed(gs *state.GlobalState, extensions []*extension, hl highlighter) error {
 	heading := color.New(color.Bold).SprintfFunc()
 	link := color.New(color.FgBlue, color.Underline).SprintfFunc()
 	text := color.New(color.Italic).SprintfFunc()
 
-	if gs.Flags.NoColor {
+	if gs.Flags.NoColor || !gs.Stdout.IsTTY {
 		heading = fmt.Sprintf
 		link = fmt.Sprintf
 		text = fmt.Sprintf
@@ -55,31 +266,338 @@ func outputDetailed(gs *state.GlobalState, extensions []*extension) error {
 	width := getTerminalWidth(gs) - listMargin
 
 	for _, ext := range extensions {
-		module := heading(ext.Module)
-		url := link(ext.Repo.URL)
-		desc := text(indent.String(wordwrap.String(ext.Description, width), listMargin))
+		module := heading(hl.apply(ext.Module))
+		desc := text(hl.apply(indent.String(wordwrap.String(description(ext), width), listMargin)))
+
+		url := ""
+		if ext.Repo != nil {
+			url = link(ext.Repo.URL)
+		}
+
+		kind := extensionType(ext)
+		if ext.Cgo {
+			kind += " (cgo)"
+		}
 
 		_, _ = fmt.Fprintf(gs.Stdout, "- %s\n  %s • %s • %s\n  %s\n",
-			module, ext.Latest, extensionType(ext), extensionTier(ext), url,
+			module, ext.Latest, kind, extensionTier(ext), url,
 		)
 		_, _ = fmt.Fprintln(gs.Stdout, desc)
+
+		if len(ext.Platforms) > 0 {
+			platforms := "Platforms: " + strings.Join(ext.Platforms, ", ")
+			_, _ = fmt.Fprintln(gs.Stdout, indent.String(wordwrap.String(platforms, width), listMargin))
+		}
+
+		if ext.Notes != "" {
+			_, _ = fmt.Fprintln(gs.Stdout, indent.String(wordwrap.String("Note: "+ext.Notes, width), listMargin))
+		}
+
+		_, _ = fmt.Fprintln(gs.Stdout)
+	}
+
+	return nil
+}
+
+// outputModules writes the module path of each extension, one per line, without header,
+// e.g. as input of xargs.
+func outputModules(gs *state.GlobalState, extensions []*extension) error {
+	for _, ext := range extensions {
+		_, _ = fmt.Fprintln(gs.Stdout, ext.Module)
+	}
+
+	return nil
+}
+
+// outputNames writes the catalog name of each extension, one per line, without header.
+// Extensions without catalog name are written as their module path.
+func outputNames(gs *state.GlobalState, extensions []*extension) error {
+	for _, ext := range extensions {
+		_, _ = fmt.Fprintln(gs.Stdout, cmp.Or(ext.name, ext.Module))
+	}
+
+	return nil
+}
+
+// outputModuleVersions writes module@latest for each extension, one per line, without
+// header, e.g. as arguments of go get. Extensions without release are written as their
+// module path.
+func outputModuleVersions(gs *state.GlobalState, extensions []*extension) error {
+	for _, ext := range extensions {
+		if ext.Latest == "" {
+			_, _ = fmt.Fprintln(gs.Stdout, ext.Module)
+
+			continue
+		}
+
+		_, _ = fmt.Fprintf(gs.Stdout, "%s@%s\n", ext.Module, ext.Latest)
+	}
+
+	return nil
+}
+
+// outputPlain writes each extension as "label: value" lines followed by an empty line,
+// without color, abbreviations, truncation or column alignment, so screen readers can
+// read it line by line. Empty values are left out.
+func outputPlain(gs *state.GlobalState, extensions []*extension, numbers numberFormat) error {
+	_, _ = fmt.Fprintf(gs.Stdout, "%s extensions\n\n", numbers.count(len(extensions)))
+
+	for _, ext := range extensions {
+		repo := ""
+		if ext.Repo != nil {
+			repo = ext.Repo.URL
+		}
+
+		status := ""
+		if isDeprecated(ext) {
+			status = "deprecated"
+		}
+
+		cgo := ""
+		if ext.Cgo {
+			cgo = "yes"
+		}
+
+		for _, line := range [][2]string{
+			{"Module", ext.Module},
+			{"Latest version", ext.Latest},
+			{"Types", strings.Join(extensionKinds(ext), ", ")},
+			{"Tier", extensionTier(ext)},
+			{"Status", status},
+			{"Requires cgo", cgo},
+			{"Description", ext.Description},
+			{"Repository", repo},
+			{"Note", ext.Notes},
+		} {
+			if line[1] != "" {
+				_, _ = fmt.Fprintf(gs.Stdout, "%s: %s\n", line[0], line[1])
+			}
+		}
+
 		_, _ = fmt.Fprintln(gs.Stdout)
 	}
 
 	return nil
 }
 
-func outputTable(gs *state.GlobalState, extensions []*extension, brief, notrunc bool) error {
-	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+// tableLayout is the layout of the table output: the columns, in order, and how the values are printed.
+type tableLayout struct {
+	columns    []string
+	notrunc    bool
+	longValues bool
+	indicator  string
+	numbers    numberFormat
+	// compat checks the k6 version constraints of the compat column, nil if the k6 version is unknown.
+	compat *compatFilter
+	// colliding are the type and tier values written in full, see collidingValues.
+	colliding map[string]bool
+}
+
+// tableColumn is a column of the table output.
+type tableColumn struct {
+	header string
+	// minWidth is the width reserved for the column when fitting the descriptions to the terminal.
+	minWidth int
+	value    func(ext *extension, layout tableLayout) string
+}
+
+// tableColumns are the columns of the table output by name. The description column is
+// the only one truncated to fit the terminal width.
+//
+//nolint:gochecknoglobals
+var tableColumns = map[string]tableColumn{
+	"module": {header: "MODULE", value: func(ext *extension, _ tableLayout) string { return ext.Module }},
+	"latest": {header: "LATEST", value: func(ext *extension, _ tableLayout) string { return ext.Latest }},
+	"type": {header: "TYPE", minWidth: typeColWidth, value: func(ext *extension, layout tableLayout) string {
+		return layout.abbrev(extensionType(ext))
+	}},
+	"tier": {header: "TIER", minWidth: tierColWidth, value: func(ext *extension, layout tableLayout) string {
+		return layout.abbrev(extensionTier(ext))
+	}},
+	"stars": {header: "STARS", value: func(ext *extension, layout tableLayout) string {
+		if ext.Repo == nil {
+			return ""
+		}
+
+		return layout.numbers.count(ext.Repo.Stars)
+	}},
+	"license": {header: "LICENSE", value: func(ext *extension, _ tableLayout) string {
+		if ext.Repo == nil {
+			return ""
+		}
+
+		return ext.Repo.License
+	}},
+	"owner": {header: "OWNER", value: func(ext *extension, _ tableLayout) string {
+		if owners := extensionOwners(ext); len(owners) > 0 {
+			return owners[0]
+		}
+
+		return ""
+	}},
+	"imports": {header: "IMPORTS", value: func(ext *extension, _ tableLayout) string { return strings.Join(ext.Imports, ",") }},
+	"outputs": {header: "OUTPUTS", value: func(ext *extension, _ tableLayout) string { return strings.Join(ext.Outputs, ",") }},
+	"subcommands": {header: "SUBCOMMANDS", value: func(ext *extension, _ tableLayout) string {
+		return strings.Join(ext.Subcommands, ",")
+	}},
+	"compat": {header: "COMPAT", value: func(ext *extension, layout tableLayout) string {
+		switch {
+		case layout.compat == nil:
+			return "?"
+		case layout.compat.filter(ext):
+			return "\u2713"
+		default:
+			return "\u2717"
+		}
+	}},
+	"constraints": {header: "CONSTRAINTS", value: func(ext *extension, _ tableLayout) string { return ext.Constraints }},
+	"description": {header: "DESCRIPTION", value: func(ext *extension, _ tableLayout) string { return description(ext) }},
+}
+
+//nolint:gochecknoglobals
+var (
+	// defaultColumns are the columns of the table output without --brief or --columns.
+	defaultColumns = []string{"module", "latest", "type", "tier", "description"}
+
+	// briefColumns are the columns of the table output with --brief.
+	briefColumns = []string{"module", "description"}
+
+	// wideColumns are the columns of the table output with --format wide.
+	wideColumns = []string{
+		"module", "latest", "type", "tier", "imports", "outputs", "subcommands",
+		"stars", "license", "constraints", "description",
+	}
+)
+
+// columnNames returns the sorted names of the table columns.
+func columnNames() []string {
+	return slices.Sorted(maps.Keys(tableColumns))
+}
+
+// abbrev abbreviates the type and tier values, unless --long-values is set. The values
+// whose abbreviation collides with another one are written in full, in lowercase.
+func (l tableLayout) abbrev(s string) string {
+	if l.longValues {
+		return s
+	}
+
+	if l.colliding[s] {
+		return strings.ToLower(s)
+	}
+
+	return abbrev(s)
+}
+
+// collidingValues returns the type and tier values of the extensions whose abbreviation is
+// shared with another value of the same column, e.g. the unknown tiers partner and parked,
+// both cut to par. They are written in full so that the table and its legend stay unambiguous.
+func collidingValues(extensions []*extension) map[string]bool {
+	colliding := make(map[string]bool)
+
+	for _, value := range []func(*extension) string{extensionType, extensionTier} {
+		seen := make(map[string]string)
+
+		for _, ext := range extensions {
+			v := value(ext)
+			short := abbrev(v)
+
+			if other, found := seen[short]; found && other != v {
+				colliding[v], colliding[other] = true, true
+
+				continue
+			}
+
+			seen[short] = v
+		}
+	}
+
+	return colliding
+}
+
+func outputTable(gs *state.GlobalState, extensions []*extension, layout tableLayout, hl highlighter) error {
+	layout.colliding = collidingValues(extensions)
+
+	if err := writeTable(gs, extensions, layout, hl); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprint(gs.Stdout, layout.legend(extensions))
+
+	return nil
+}
+
+// outputGroupedTable writes a table per group of extensions, e.g. per tier, each under a
+// heading with the size of the group, in bold on a terminal. The abbreviations are
+// explained once, after the last table.
+func outputGroupedTable(
+	gs *state.GlobalState,
+	extensions []*extension,
+	groups groupBy,
+	layout tableLayout,
+	hl highlighter,
+) error {
+	heading := color.New(color.Bold).SprintfFunc()
+	if gs.Flags.NoColor || !gs.Stdout.IsTTY {
+		heading = fmt.Sprintf
+	}
+
+	// The abbreviations are the same in all the tables, like in the legend.
+	layout.colliding = collidingValues(extensions)
+
+	sorted := slices.Clone(extensions)
+	slices.SortStableFunc(sorted, groups.compare)
+
+	for len(sorted) > 0 {
+		label := groups.label(sorted[0])
+
+		size := 1
+		for size < len(sorted) && groups.label(sorted[size]) == label {
+			size++
+		}
+
+		_, _ = fmt.Fprintln(gs.Stdout, heading("%s (%d)", label, size))
+
+		if err := writeTable(gs, sorted[:size], layout, hl); err != nil {
+			return err
+		}
+
+		sorted = sorted[size:]
+
+		if len(sorted) > 0 {
+			_, _ = fmt.Fprintln(gs.Stdout)
+		}
+	}
+
+	_, _ = fmt.Fprint(gs.Stdout, layout.legend(extensions))
+
+	return nil
+}
+
+// writeTable writes the extensions as a table, without the legend of the abbreviations.
+func writeTable(gs *state.GlobalState, extensions []*extension, layout tableLayout, hl highlighter) error {
+	// The table is laid out without highlighting, as escape sequences would break the alignment.
+	var table bytes.Buffer
+
+	w := tabwriter.NewWriter(&table, 0, 0, columnPadding, ' ', 0)
 	termWidth := getTerminalWidth(gs)
 	otherCols := 0
 
+	columns := make([]tableColumn, 0, len(layout.columns))
+	headers := make([]string, 0, len(layout.columns))
+
+	for _, name := range layout.columns {
+		columns = append(columns, tableColumns[name])
+		headers = append(headers, tableColumns[name].header)
+	}
+
 	// Calculate max description width based on terminal width and other columns
 	for _, ext := range extensions {
-		otherLen := len(ext.Module)
+		otherLen := 0
 
-		if !brief {
-			otherLen += len(ext.Latest) + typeColWidth + tierColWidth
+		for i, name := range layout.columns {
+			if name != "description" {
+				otherLen += max(utf8.RuneCountInString(columns[i].value(ext, layout)), columns[i].minWidth)
+			}
 		}
 
 		if otherLen > otherCols {
@@ -87,41 +605,107 @@ func outputTable(gs *state.GlobalState, extensions []*extension, brief, notrunc
 		}
 	}
 
-	if brief {
-		otherCols += briefPaddings
-	} else {
-		otherCols += normalPaddings
-	}
+	otherCols += columnPadding * len(columns)
 
 	descWidth := max(termWidth-otherCols, minDescWidth)
 
-	if brief {
-		_, _ = w.Write([]byte(briefHeader))
-	} else {
-		_, _ = w.Write([]byte(normalHeader))
-	}
+	_, _ = w.Write([]byte(strings.Join(headers, "\t") + "\n"))
 
 	for _, ext := range extensions {
-		module := ext.Module
-		latest := ext.Latest
-		typ := abbrev(extensionType(ext))
-		tier := abbrev(extensionTier(ext))
+		values := make([]string, 0, len(columns))
+
+		for i, name := range layout.columns {
+			value := columns[i].value(ext, layout)
+			if name == "description" && !layout.notrunc {
+				value = truncate(value, descWidth, layout.indicator)
+			}
 
-		desc := ext.Description
-		if !notrunc && len(desc) > descWidth {
-			desc = desc[:descWidth-dotsLen] + dots
+			values = append(values, value)
 		}
 
-		if brief {
-			_, _ = w.Write([]byte(module + "\t" + desc + "\n"))
+		_, _ = w.Write([]byte(strings.Join(values, "\t") + "\n"))
+	}
 
-			continue
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	header, rows, _ := strings.Cut(table.String(), "\n")
+
+	_, _ = fmt.Fprintln(gs.Stdout, header)
+
+	for row := range strings.Lines(rows) {
+		_, _ = fmt.Fprint(gs.Stdout, hl.apply(row))
+	}
+
+	return nil
+}
+
+// legend explains the abbreviations of the type and tier columns used in the table,
+// e.g. "com: Community", in alphabetical order of the abbreviations. It is empty when
+// no abbreviated column is shown.
+func (l tableLayout) legend(extensions []*extension) string {
+	showTypes, showTiers := slices.Contains(l.columns, "type"), slices.Contains(l.columns, "tier")
+	if l.longValues || (!showTypes && !showTiers) || len(extensions) == 0 {
+		return ""
+	}
+
+	types := make(map[string]string)
+	tiers := make(map[string]string)
+
+	for _, ext := range extensions {
+		if typ := extensionType(ext); typ != "" && showTypes {
+			types[l.abbrev(typ)] = typ
 		}
 
-		_, _ = w.Write([]byte(module + "\t" + latest + "\t" + typ + "\t" + tier + "\t" + desc + "\n"))
+		if showTiers {
+			tier := extensionTier(ext)
+			tiers[l.abbrev(tier)] = tier
+		}
+	}
+
+	explain := func(values map[string]string) string {
+		parts := make([]string, 0, len(values))
+		for _, short := range slices.Sorted(maps.Keys(values)) {
+			parts = append(parts, short+": "+values[short])
+		}
+
+		return strings.Join(parts, ", ")
+	}
+
+	var buf strings.Builder
+
+	buf.WriteString("\n")
+
+	if len(types) > 0 {
+		buf.WriteString("TYPE  " + explain(types) + "\n")
 	}
 
-	return w.Flush()
+	if len(tiers) > 0 {
+		buf.WriteString("TIER  " + explain(tiers) + "\n")
+	}
+
+	return buf.String()
+}
+
+// truncate shortens s to at most width runes, ending with the indicator when cut.
+// The result never exceeds the width: if the indicator does not fit, s is just cut.
+func truncate(s string, width int, indicator string) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+
+	if width <= 0 {
+		return ""
+	}
+
+	dots := []rune(indicator)
+	if len(dots) >= width {
+		return string(runes[:width])
+	}
+
+	return string(runes[:width-len(dots)]) + indicator
 }
 
 func extensionType(e *extension) string {
@@ -137,18 +721,28 @@ func extensionType(e *extension) string {
 		return "Subcommand"
 	}
 
+	// Types unknown to this release are capitalized, like unknown tiers.
+	if kinds := extensionKinds(e); len(kinds) > 0 {
+		return capitalize(kinds[0])
+	}
+
 	return ""
 }
 
+// extensionTier returns the display name of the tier. Tiers unknown to this release
+// are capitalized instead of being collapsed into Community.
 func extensionTier(e *extension) string {
-	switch e.Tier {
-	case "official":
-		return "Official"
-	case "community":
-		fallthrough
-	default:
-		return "Community"
+	return capitalize(normalizedTier(e))
+}
+
+// capitalize returns the string with its first rune in upper case.
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
 	}
+
+	return string(unicode.ToUpper(r)) + s[size:]
 }
 
 func abbrev(s string) string {
@@ -164,8 +758,167 @@ func abbrev(s string) string {
 	case "Community":
 		return "com"
 	default:
-		return s
+		// Unknown types and tiers are cut to fit their column, by runes so non-ASCII names stay valid.
+		runes := []rune(s)
+
+		return strings.ToLower(string(runes[:min(len(runes), tierColWidth-1)]))
+	}
+}
+
+// timeZone returns the time zone of the dates in the human-facing output:
+// the local time zone, or UTC with --utc.
+func timeZone(utc bool) *time.Location {
+	if utc {
+		return time.UTC
+	}
+
+	return time.Local
+}
+
+// dateFormat formats the dates of the human-facing output, either relative to now,
+// e.g. 3 weeks ago, or as exact dates in a time zone.
+type dateFormat struct {
+	loc      *time.Location
+	relative bool
+	now      time.Time
+}
+
+// newDateFormat returns the date format given by --utc, --relative-dates and --absolute-dates.
+// Dates are relative on a terminal and absolute otherwise, e.g. in CI logs, unless a flag
+// says otherwise.
+func newDateFormat(opts options) (dateFormat, error) {
+	if opts.relativeDates && opts.absoluteDates {
+		return dateFormat{}, errConflictingDates
+	}
+
+	relative := opts.gs.Stdout.IsTTY
+	if opts.relativeDates || opts.absoluteDates {
+		relative = opts.relativeDates
+	}
+
+	return dateFormat{loc: timeZone(opts.utc), relative: relative, now: time.Now()}, nil
+}
+
+// dateTime formats a point in time. Absolute UTC times are formatted as RFC 3339,
+// the ISO format expected with --utc.
+func (f dateFormat) dateTime(t time.Time) string {
+	switch {
+	case f.relative:
+		return relativeTime(t, f.now)
+	case f.loc == time.UTC:
+		return t.UTC().Format(time.RFC3339)
+	default:
+		return t.In(f.loc).Format(localTimeLayout)
+	}
+}
+
+// date formats the day of a point in time.
+func (f dateFormat) date(t time.Time) string {
+	if f.relative {
+		return relativeTime(t, f.now)
+	}
+
+	return t.In(f.loc).Format(time.DateOnly)
+}
+
+// relativeTime formats the time elapsed from t to now in the largest fitting unit, e.g. 3 weeks ago.
+func relativeTime(t, now time.Time) string {
+	const (
+		day   = 24 * time.Hour
+		week  = 7 * day
+		month = 30 * day
+		year  = 365 * day
+	)
+
+	elapsed := now.Sub(t)
+
+	for _, unit := range []struct {
+		name string
+		size time.Duration
+	}{
+		{"year", year}, {"month", month}, {"week", week}, {"day", day}, {"hour", time.Hour}, {"minute", time.Minute},
+	} {
+		if n := int(elapsed / unit.size); n > 0 {
+			if n > 1 {
+				return fmt.Sprintf("%d %ss ago", n, unit.name)
+			}
+
+			return "1 " + unit.name + " ago"
+		}
 	}
+
+	return "just now"
+}
+
+// numberFormat formats the counts of the human-facing output, e.g. repository stars,
+// as plain digits, with the thousands separators of the locale, or in compact notation.
+type numberFormat struct {
+	style   numberStyle
+	printer *message.Printer
+}
+
+// newNumberFormat returns the number format given by --number-format, in the locale of
+// the LC_ALL, LC_NUMERIC or LANG environment variable.
+func newNumberFormat(opts options) numberFormat {
+	return numberFormat{style: opts.numbers, printer: message.NewPrinter(locale(opts.gs.Env))}
+}
+
+// locale returns the language of the numeric locale, e.g. de for de_DE.UTF-8.
+// The C and POSIX locales, unset and unknown locales fall back to English.
+func locale(env map[string]string) language.Tag {
+	for _, name := range []string{"LC_ALL", "LC_NUMERIC", "LANG"} {
+		value := env[name]
+		if value == "" {
+			continue
+		}
+
+		value, _, _ = strings.Cut(value, ".")
+		value, _, _ = strings.Cut(value, "@")
+
+		if tag, err := language.Parse(strings.ReplaceAll(value, "_", "-")); err == nil && value != "C" {
+			return tag
+		}
+
+		break
+	}
+
+	return language.English
+}
+
+// compactUnits are the units of the compact number format, the smallest first.
+//
+//nolint:gochecknoglobals
+var compactUnits = []struct {
+	size   float64
+	suffix string
+}{
+	{size: 1e3, suffix: "k"},
+	{size: 1e6, suffix: "M"},
+	{size: 1e9, suffix: "B"},
+}
+
+// count formats a count, e.g. 12345 as 12345, 12,345 or 12.3k.
+// The compact unit is chosen after rounding, so 999950 is 1M rather than 1,000k.
+func (f numberFormat) count(n int) string {
+	const thousand = 1_000
+
+	switch {
+	case f.printer == nil || f.style == "" || f.style == numbersPlain:
+		return fmt.Sprint(n)
+	case f.style == numbersGrouped || n < thousand && n > -thousand:
+		return f.printer.Sprint(number.Decimal(n))
+	}
+
+	for i, unit := range compactUnits {
+		// Rounded to one fraction digit, like the printed value.
+		rounded := math.Round(float64(n)/unit.size*10) / 10 //nolint:mnd
+
+		if math.Abs(rounded) < thousand || i == len(compactUnits)-1 {
+			return f.printer.Sprint(number.Decimal(rounded, number.MaxFractionDigits(1))) + unit.suffix
+		}
+	}
+
+	return fmt.Sprint(n)
 }
 
 func getTerminalWidth(gs *state.GlobalState) int {
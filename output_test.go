@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	cmdtests "go.k6.io/k6/v2/cmd/tests"
@@ -37,6 +38,11 @@ func TestExtensionType(t *testing.T) {
 			ext:  &extension{},
 			want: "",
 		},
+		{
+			name: "type unknown to this release",
+			ext:  &extension{Capabilities: map[string][]string{"secret-source": {"vault"}}},
+			want: "Secret-source",
+		},
 		{
 			name: "multiple imports",
 			ext:  &extension{Imports: []string{"k6/x/faker", "k6/x/other"}},
@@ -96,9 +102,9 @@ func TestExtensionTier(t *testing.T) {
 			want: "community",
 		},
 		{
-			name: "unknown tier defaults to community",
-			ext:  &extension{Tier: "unknown"},
-			want: "community",
+			name: "tier unknown to this release is kept",
+			ext:  &extension{Tier: "partner"},
+			want: "partner",
 		},
 	}
 
@@ -114,6 +120,48 @@ func TestExtensionTier(t *testing.T) {
 	}
 }
 
+func TestUnknownTierRendering(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/example/xk6-community", Imports: []string{"k6/x/community"}},
+		{Module: "github.com/example/xk6-partner", Tier: "Partner", Imports: []string{"k6/x/partner"}},
+		{Module: "github.com/grafana/xk6-official", Tier: "official", Imports: []string{"k6/x/official"}},
+	}
+
+	sortExtensions(extensions, nil)
+
+	require.Equal(t, "github.com/grafana/xk6-official", extensions[0].Module)
+	require.Equal(t, "github.com/example/xk6-partner", extensions[1].Module)
+	require.Equal(t, "github.com/example/xk6-community", extensions[2].Module)
+
+	require.Equal(t, "Partner", extensionTier(extensions[1]))
+	require.Equal(t, "par", abbrev(extensionTier(extensions[1])))
+	require.Equal(t, "Élite", extensionTier(&extension{Tier: "élite"}))
+	require.Equal(t, "Ünknown", extensionType(&extension{Capabilities: map[string][]string{"ünknown": {"x"}}}))
+	require.Equal(t, "éli", abbrev("Élite"))
+	require.Equal(t, "ab", abbrev("Ab"))
+
+	// Unknown tiers sharing their abbreviation are written in full, in the table and its legend.
+	extensions = append(extensions, &extension{
+		Module: "github.com/example/xk6-parked", Tier: "parked", Imports: []string{"k6/x/parked"},
+	})
+
+	require.Equal(t, map[string]bool{"Partner": true, "Parked": true}, collidingValues(extensions))
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputTable(ts.GlobalState, extensions, tableLayout{columns: []string{"module", "tier"}}, nil))
+	require.Equal(t, `MODULE                            TIER
+github.com/grafana/xk6-official   off
+github.com/example/xk6-partner    partner
+github.com/example/xk6-community  com
+github.com/example/xk6-parked     parked
+
+TIER  com: Community, off: Official, parked: Parked, partner: Partner
+`, ts.Stdout.String())
+}
+
 func TestOutputJSON(t *testing.T) {
 	t.Parallel()
 
@@ -184,6 +232,121 @@ func TestOutputJSON(t *testing.T) {
 	}
 }
 
+func TestOutputJSONArray(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	extensions := []*extension{{Module: "github.com/grafana/xk6-faker"}}
+
+	// Without --json-meta, the output stays a bare array, for the jq '.[]' queries.
+	require.NoError(t, output(options{gs: ts.GlobalState, json: true}, extensions, time.Now()))
+
+	var got []map[string]any
+
+	require.NoError(t, json.Unmarshal(ts.Stdout.Bytes(), &got))
+	require.Len(t, got, 1)
+	require.Equal(t, "github.com/grafana/xk6-faker", got[0]["module"])
+}
+
+func TestOutputJSONMeta(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	summary := newRunSummary(ts.GlobalState, true)
+	summary.fetchedFrom(sourceRegistry, "https://registry.k6.io/catalog.json", cacheHit, 3)
+	summary.loaded(2)
+
+	fetchedAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	extensions := []*extension{{Module: "github.com/grafana/xk6-faker"}}
+
+	require.NoError(t, output(options{gs: ts.GlobalState, jsonMeta: true, summary: summary}, extensions, fetchedAt))
+
+	var got map[string]any
+
+	require.NoError(t, json.Unmarshal(ts.Stdout.Bytes(), &got))
+	require.InDelta(t, jsonSchemaVersion, got["schemaVersion"], 0)
+	require.Equal(t, "https://registry.k6.io/catalog.json", got["catalogURL"])
+	require.Equal(t, "2025-06-01T00:00:00Z", got["fetchedAt"])
+	require.Equal(t, true, got["cacheHit"])
+	require.InDelta(t, 2, got["totalCount"], 0)
+	require.InDelta(t, 1, got["filteredCount"], 0)
+	require.Len(t, got["extensions"], 1)
+}
+
+func TestOutputJSONFieldOrder(t *testing.T) {
+	t.Parallel()
+
+	ext := &extension{
+		Module: "github.com/grafana/xk6-faker", Tier: "official", Description: "Fake data", Latest: "v0.4.4",
+		Versions: []string{"v0.4.4"}, Imports: []string{"k6/x/faker"}, Outputs: []string{"fake"},
+		Subcommands: []string{"faker"}, Capabilities: map[string][]string{"secret-source": {"fake"}},
+		Categories: []string{"data"}, Products: []string{"oss"}, Constraints: ">=v1.0.0",
+		Repo: &repository{URL: "https://github.com/grafana/xk6-faker"}, Notes: "Approved",
+		Platforms: []string{"linux/amd64"}, Cgo: true, Deprecation: &deprecation{Since: "2025-01-01"},
+	}
+
+	data, err := json.Marshal(toJSON(ext))
+	require.NoError(t, err)
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+
+	_, err = decoder.Token()
+	require.NoError(t, err)
+
+	var keys []string
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		require.NoError(t, err)
+
+		keys = append(keys, key.(string)) //nolint:forcetypeassert
+
+		var value json.RawMessage
+		require.NoError(t, decoder.Decode(&value))
+	}
+
+	require.Equal(t, []string{
+		"module", "tier", "type", "description", "latest", "latestStable", "latestPrerelease", "versions", "kinds",
+		"imports", "outputs", "subcommands", "capabilities", "categories", "products", "constraints", "repo",
+		"notes", "platforms", "cgo", "deprecated", "deprecation",
+	}, keys)
+}
+
+func TestOutputNDJSON(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Tier: "official", Versions: []string{"v0.4.4", "v0.4.3"}},
+		{Module: "github.com/grafana/xk6-tls", Versions: []string{"v0.1.0"}},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, output(options{gs: ts.GlobalState, format: formatNDJSON, maxVersions: 1}, extensions, time.Now()))
+
+	lines := strings.Split(strings.TrimSuffix(ts.Stdout.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	for i, line := range lines {
+		var got struct {
+			SchemaVersion int `json:"schemaVersion"`
+			extension
+		}
+
+		require.NoError(t, json.Unmarshal([]byte(line), &got))
+		require.Equal(t, jsonSchemaVersion, got.SchemaVersion)
+		require.Equal(t, extensions[i].Module, got.Module)
+		require.Len(t, got.Versions, 1)
+	}
+
+	ts = cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, output(options{gs: ts.GlobalState, format: formatNDJSON}, nil, time.Now()))
+	require.Empty(t, ts.Stdout.String())
+}
+
 func TestOutputTable(t *testing.T) {
 	t.Parallel()
 
@@ -249,7 +412,12 @@ func TestOutputTable(t *testing.T) {
 
 			ts := cmdtests.NewGlobalTestState(t)
 
-			err := outputTable(ts.GlobalState, tt.extensions, tt.brief, true)
+			columns := defaultColumns
+			if tt.brief {
+				columns = briefColumns
+			}
+
+			err := outputTable(ts.GlobalState, tt.extensions, tableLayout{columns: columns, notrunc: true}, nil)
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -265,6 +433,36 @@ func TestOutputTable(t *testing.T) {
 	}
 }
 
+func TestOutputDetailed(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{
+			Module:      "github.com/grafana/xk6-faker",
+			Tier:        "official",
+			Description: "Generate fake data",
+			Latest:      "v0.4.4",
+			Imports:     []string{"k6/x/faker"},
+			Repo:        &repository{URL: "https://github.com/grafana/xk6-faker"},
+		},
+		{
+			Module:      "github.com/grafana/xk6-norepo",
+			Description: "Extension without repository information",
+			Latest:      "v0.1.0",
+			Outputs:     []string{"norepo"},
+		},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputDetailed(ts.GlobalState, extensions, nil))
+
+	output := ts.Stdout.String()
+	require.Contains(t, output, "- github.com/grafana/xk6-faker\n  v0.4.4 • JavaScript • Official\n  https://github.com/grafana/xk6-faker\n")
+	require.Contains(t, output, "- github.com/grafana/xk6-norepo\n  v0.1.0 • Output • Community\n")
+	require.NotContains(t, output, "\x1b[", "no ANSI sequences without a TTY")
+}
+
 func TestGetTerminalWidth(t *testing.T) {
 	t.Parallel()
 
@@ -274,3 +472,440 @@ func TestGetTerminalWidth(t *testing.T) {
 
 	require.Equal(t, defaultTerminalWidth, got)
 }
+
+func TestOutputJSONComputedFields(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	extensions := []*extension{
+		{
+			Module:       "github.com/grafana/xk6-dashboard",
+			Tier:         "Official",
+			Outputs:      []string{"dashboard"},
+			Subcommands:  []string{"dashboard"},
+			Capabilities: map[string][]string{"secret-source": {"dashboard"}},
+			Versions:     []string{"v0.7.0", "v0.8.0-rc.1"},
+		},
+		{Module: "github.com/example/xk6-empty"},
+	}
+
+	require.NoError(t, outputJSON(ts.GlobalState, toJSONList(extensions)))
+
+	var result []map[string]any
+
+	require.NoError(t, json.Unmarshal(ts.Stdout.Bytes(), &result))
+	require.Len(t, result, 2)
+	require.Equal(t, "github.com/grafana/xk6-dashboard", result[0]["module"])
+	require.Equal(t, []any{"output", "subcommand", "secret-source"}, result[0]["kinds"])
+	require.Equal(t, "official", result[0]["tier"])
+	require.Equal(t, "output", result[0]["type"])
+	require.Equal(t, "v0.7.0", result[0]["latestStable"])
+	require.Equal(t, "v0.8.0-rc.1", result[0]["latestPrerelease"])
+	require.Equal(t, []any{}, result[1]["kinds"])
+	require.Equal(t, "community", result[1]["tier"])
+	require.Empty(t, result[1]["type"])
+}
+
+func TestTrimVersions(t *testing.T) {
+	t.Parallel()
+
+	faker := &extension{
+		Module:   "github.com/grafana/xk6-faker",
+		Versions: []string{"v0.3.0", "v0.4.4", "v0.5.0-rc.1", "v0.4.3"},
+	}
+	sql := &extension{Module: "github.com/grafana/xk6-sql", Versions: []string{"v1.0.0"}}
+
+	list := trimVersions(toJSONList([]*extension{faker, sql}), 2)
+
+	require.Equal(t, []string{"v0.5.0-rc.1", "v0.4.4"}, list[0].Versions)
+	require.Equal(t, "v0.4.4", list[0].LatestStable)
+	require.Equal(t, []string{"v1.0.0"}, list[1].Versions)
+	require.Len(t, faker.Versions, 4, "the catalog entry is left unchanged")
+
+	require.Len(t, trimVersions(toJSONList([]*extension{faker}), 0)[0].Versions, 4)
+}
+
+func TestRelativeTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{ago: 30 * time.Second, want: "just now"},
+		{ago: time.Minute, want: "1 minute ago"},
+		{ago: 5 * time.Hour, want: "5 hours ago"},
+		{ago: 3 * 24 * time.Hour, want: "3 days ago"},
+		{ago: 22 * 24 * time.Hour, want: "3 weeks ago"},
+		{ago: 90 * 24 * time.Hour, want: "3 months ago"},
+		{ago: 800 * 24 * time.Hour, want: "2 years ago"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, relativeTime(now.Add(-tt.ago), now))
+	}
+}
+
+func TestDateFormat(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+	opts := options{gs: ts.GlobalState, utc: true}
+
+	dates, err := newDateFormat(opts)
+	require.NoError(t, err)
+	require.False(t, dates.relative, "absolute when not on a terminal")
+	require.Equal(t, "2025-03-01T23:30:00Z", dates.dateTime(time.Date(2025, 3, 1, 23, 30, 0, 0, time.UTC)))
+
+	opts.relativeDates = true
+
+	dates, err = newDateFormat(opts)
+	require.NoError(t, err)
+	require.Equal(t, "2 days ago", dates.date(dates.now.Add(-49*time.Hour)))
+
+	opts.absoluteDates = true
+
+	_, err = newDateFormat(opts)
+	require.ErrorIs(t, err, errConflictingDates)
+}
+
+func TestNumberFormat(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+	opts := options{gs: ts.GlobalState}
+
+	require.Equal(t, "1234567", newNumberFormat(opts).count(1234567))
+
+	opts.numbers = numbersGrouped
+
+	require.Equal(t, "1,234,567", newNumberFormat(opts).count(1234567))
+
+	ts.Env["LANG"] = "de_DE.UTF-8"
+
+	require.Equal(t, "1.234.567", newNumberFormat(opts).count(1234567))
+
+	opts.numbers = numbersCompact
+
+	for n, want := range map[int]string{
+		999:               "999",
+		-999:              "-999",
+		1000:              "1k",
+		12345:             "12,3k",
+		12000:             "12k",
+		999_949:           "999,9k",
+		999_950:           "1M",
+		-999_950:          "-1M",
+		1234567:           "1,2M",
+		999_949_999:       "999,9M",
+		999_950_000:       "1B",
+		1_234_567_890:     "1,2B",
+		2_000_000_000_000: "2.000B",
+	} {
+		require.Equal(t, want, newNumberFormat(opts).count(n), n)
+	}
+
+	ts.Env["LC_ALL"] = "C"
+
+	require.Equal(t, "12.3k", newNumberFormat(opts).count(12345))
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		s         string
+		width     int
+		indicator string
+		want      string
+	}{
+		{name: "fits", s: "Generate fake data", width: 20, indicator: "...", want: "Generate fake data"},
+		{name: "ascii", s: "Generate fake data", width: 10, indicator: "...", want: "Generat..."},
+		{name: "unicode", s: "Generate fake data", width: 10, indicator: "…", want: "Generate …"},
+		{name: "multibyte runes", s: "Générer des données", width: 8, indicator: "...", want: "Génér..."},
+		{name: "narrower than indicator", s: "Generate fake data", width: 2, indicator: "...", want: "Ge"},
+		{name: "zero width", s: "Generate fake data", width: 0, indicator: "...", want: ""},
+		{name: "negative width", s: "Generate fake data", width: -5, indicator: "...", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, truncate(tt.s, tt.width, tt.indicator))
+		})
+	}
+}
+
+func TestEllipsisIndicator(t *testing.T) {
+	t.Parallel()
+
+	var e ellipsis
+
+	require.Equal(t, "...", e.indicator())
+	require.NoError(t, e.Set("unicode"))
+	require.Equal(t, "…", e.indicator())
+	require.ErrorIs(t, e.Set("dots"), errInvalidEllipsis)
+}
+
+func TestOutputPlain(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	extensions := []*extension{
+		{
+			Module:      "github.com/grafana/xk6-dashboard",
+			Tier:        "official",
+			Latest:      "v0.7.5",
+			Description: "A web-based metrics dashboard for k6",
+			Outputs:     []string{"dashboard"},
+			Subcommands: []string{"dashboard"},
+			Repo:        &repository{URL: "https://github.com/grafana/xk6-dashboard"},
+		},
+		{Module: "github.com/example/xk6-bare"},
+	}
+
+	require.NoError(t, outputPlain(ts.GlobalState, extensions, numberFormat{}))
+	require.Equal(t, `2 extensions
+
+Module: github.com/grafana/xk6-dashboard
+Latest version: v0.7.5
+Types: output, subcommand
+Tier: Official
+Description: A web-based metrics dashboard for k6
+Repository: https://github.com/grafana/xk6-dashboard
+
+Module: github.com/example/xk6-bare
+Tier: Community
+
+`, ts.Stdout.String())
+}
+
+func TestOutputTableLegend(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Tier: "official", Latest: "v0.4.4", Imports: []string{"k6/x/faker"}},
+		{Module: "github.com/example/xk6-output-foo", Latest: "v0.1.0", Outputs: []string{"foo"}},
+	}
+
+	t.Run("abbreviations", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+
+		require.NoError(t, outputTable(ts.GlobalState, extensions, tableLayout{columns: defaultColumns, notrunc: true}, nil))
+		require.Contains(t, ts.Stdout.String(), " js    off ")
+		require.True(t, strings.HasSuffix(ts.Stdout.String(),
+			"\nTYPE  js: JavaScript, out: Output\nTIER  com: Community, off: Official\n"))
+	})
+
+	t.Run("long values", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+
+		require.NoError(t, outputTable(ts.GlobalState, extensions,
+			tableLayout{columns: defaultColumns, notrunc: true, longValues: true}, nil))
+		require.Contains(t, ts.Stdout.String(), " JavaScript  Official ")
+		require.Contains(t, ts.Stdout.String(), " Output      Community")
+		require.NotContains(t, ts.Stdout.String(), "com: Community")
+	})
+
+	t.Run("brief", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+
+		require.NoError(t, outputTable(ts.GlobalState, extensions, tableLayout{columns: briefColumns, notrunc: true}, nil))
+		require.NotContains(t, ts.Stdout.String(), "com: Community")
+	})
+}
+
+func TestOutputTableColumns(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{
+			Module: "github.com/grafana/xk6-faker", Tier: "official", Latest: "v0.4.4", Description: "Fake data",
+			Repo: &repository{Owner: "grafana", Stars: 12345, License: "AGPL-3.0"},
+		},
+		{Module: "github.com/example/xk6-local", Latest: "v0.1.0", Description: "Local", Repo: &repository{License: "MIT"}},
+	}
+
+	var columns columnList
+
+	require.NoError(t, columns.Set("module, Stars"))
+	require.NoError(t, columns.Set("tier,license,module"))
+	require.Equal(t, "module,stars,tier,license", columns.String())
+	require.ErrorIs(t, columns.Set("downloads"), errInvalidColumn)
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	layout := tableLayout{columns: columns, numbers: newNumberFormat(options{gs: ts.GlobalState, numbers: numbersCompact})}
+
+	require.NoError(t, outputTable(ts.GlobalState, extensions, layout, nil))
+	require.Equal(t, `MODULE                        STARS  TIER  LICENSE
+github.com/grafana/xk6-faker  12.3k  off   AGPL-3.0
+github.com/example/xk6-local  0      com   MIT
+
+TIER  com: Community, off: Official
+`, ts.Stdout.String())
+}
+
+func TestOutputCount(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	extensions := []*extension{{Module: "github.com/grafana/xk6-faker"}, {Module: "github.com/grafana/xk6-sql"}}
+
+	require.NoError(t, output(options{gs: ts.GlobalState, count: true}, extensions, time.Now()))
+	require.Equal(t, "2\n", ts.Stdout.String())
+}
+
+func TestOutputQuiet(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{{Module: "github.com/grafana/xk6-faker"}, {Module: "github.com/grafana/xk6-sql"}}
+
+	ts := cmdtests.NewGlobalTestState(t)
+	ts.Flags.Quiet = true
+
+	require.NoError(t, output(options{gs: ts.GlobalState}, extensions, time.Now()))
+	require.Equal(t, "github.com/grafana/xk6-faker\ngithub.com/grafana/xk6-sql\n", ts.Stdout.String())
+
+	ts = cmdtests.NewGlobalTestState(t)
+	ts.Flags.Quiet = true
+
+	require.NoError(t, output(options{gs: ts.GlobalState, brief: true}, extensions, time.Now()))
+	require.Contains(t, ts.Stdout.String(), "MODULE")
+}
+
+func TestOutputPresets(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4", name: "xk6-faker"},
+		{Module: "github.com/example/xk6-unreleased"},
+	}
+
+	for _, tt := range []struct {
+		format format
+		want   string
+	}{
+		{formatName, "xk6-faker\ngithub.com/example/xk6-unreleased\n"},
+		{formatModule, "github.com/grafana/xk6-faker\ngithub.com/example/xk6-unreleased\n"},
+		{formatModuleVersion, "github.com/grafana/xk6-faker@v0.4.4\ngithub.com/example/xk6-unreleased\n"},
+	} {
+		t.Run(string(tt.format), func(t *testing.T) {
+			t.Parallel()
+
+			ts := cmdtests.NewGlobalTestState(t)
+
+			require.NoError(t, output(options{gs: ts.GlobalState, format: tt.format}, extensions, time.Now()))
+			require.Equal(t, tt.want, ts.Stdout.String())
+		})
+	}
+}
+
+func TestOutputCompatColumn(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Tier: "official", Constraints: ">=v1.0.0", Description: "Fake data"},
+		{Module: "github.com/grafana/xk6-sql", Tier: "official", Constraints: "<v1.0.0", Description: "SQL"},
+		{Module: "github.com/grafana/xk6-any", Tier: "official", Description: "Any"},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	opts := options{gs: ts.GlobalState, annotate: true, brief: true, notrunc: true, k6Version: "v1.2.0-rc1"}
+
+	require.NoError(t, output(opts, extensions, time.Now()))
+	require.Equal(t, `MODULE                        COMPAT  DESCRIPTION
+github.com/grafana/xk6-faker  ✓       Fake data
+github.com/grafana/xk6-sql    ✗       SQL
+github.com/grafana/xk6-any    ✓       Any
+`, ts.Stdout.String())
+}
+
+func TestOutputWide(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{
+			Module: "github.com/grafana/xk6-faker", Tier: "official", Latest: "v0.4.4", Imports: []string{"k6/x/faker"},
+			Constraints: ">=v1.0.0", Description: strings.Repeat("Generate fake data ", 20),
+			Repo: &repository{Stars: 120, License: "AGPL-3.0"},
+		},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, output(options{gs: ts.GlobalState, format: formatWide}, extensions, time.Now()))
+
+	header, row, _ := strings.Cut(ts.Stdout.String(), "\n")
+
+	require.Equal(t, []string{
+		"MODULE", "LATEST", "TYPE", "TIER", "IMPORTS", "OUTPUTS", "SUBCOMMANDS",
+		"STARS", "LICENSE", "CONSTRAINTS", "DESCRIPTION",
+	}, strings.Fields(header))
+	require.Contains(t, row, "k6/x/faker")
+	require.Contains(t, row, ">=v1.0.0")
+	require.Contains(t, row, extensions[0].Description+"\n", "not truncated")
+}
+
+func TestOutputGroupedTable(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/example/xk6-output-foo", Description: "Foo output", Outputs: []string{"foo"}},
+		{Module: "github.com/grafana/xk6-faker", Tier: "official", Description: "Fake data", Imports: []string{"k6/x/faker"}},
+		{Module: "github.com/grafana/xk6-sql", Tier: "official", Description: "SQL", Imports: []string{"k6/x/sql"}},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputGroupedTable(ts.GlobalState, extensions, groupByTier,
+		tableLayout{columns: briefColumns, notrunc: true}, nil))
+	require.Equal(t, `Official (2)
+MODULE                        DESCRIPTION
+github.com/grafana/xk6-faker  Fake data
+github.com/grafana/xk6-sql    SQL
+
+Community (1)
+MODULE                             DESCRIPTION
+github.com/example/xk6-output-foo  Foo output
+`, ts.Stdout.String())
+
+	var group groupBy
+
+	require.NoError(t, group.Set("owner"))
+	require.Equal(t, "example", group.label(extensions[0]))
+	require.Equal(t, "Other", group.label(&extension{Module: "xk6-local"}))
+	require.ErrorIs(t, group.Set("license"), errInvalidGroupBy)
+}
+
+func TestDeprecatedBadge(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	extensions := []*extension{
+		{Module: "github.com/example/xk6-archived", Description: "Old", Repo: &repository{Archived: true}},
+		{Module: "github.com/example/xk6-current", Description: "New"},
+	}
+
+	require.NoError(t, outputTable(ts.GlobalState, extensions, tableLayout{columns: briefColumns, notrunc: true}, nil))
+	require.Contains(t, ts.Stdout.String(), "github.com/example/xk6-archived  [deprecated] Old\n")
+	require.Contains(t, ts.Stdout.String(), "github.com/example/xk6-current   New\n")
+
+	require.True(t, toJSON(extensions[0]).Deprecated)
+	require.False(t, toJSON(extensions[1]).Deprecated)
+}
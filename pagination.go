@@ -0,0 +1,237 @@
+package explore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// maxConcurrentPages bounds the number of catalog pages fetched in parallel.
+	maxConcurrentPages = 4
+
+	// maxCatalogPages protects against runaway pagination.
+	maxCatalogPages = 1000
+
+	pageParam = "page"
+)
+
+var errPagination = errors.New("invalid catalog pagination")
+
+// fetchRemainingPages follows the pagination links of the first catalog page and merges
+// the extensions of the following pages into catalog.
+//
+// When the registry advertises both the next and the last page using a numeric page
+// query parameter, the remaining pages are known up front and fetched concurrently.
+// Otherwise the next links are followed one by one.
+func (f *catalogFetcher) fetchRemainingPages(
+	ctx context.Context,
+	catalog map[string]*extension,
+	links map[string]string,
+) error {
+	if urls := pageURLs(links); len(urls) > 0 {
+		return f.fetchPagesConcurrently(ctx, catalog, urls)
+	}
+
+	seen := make(map[string]bool)
+
+	for links["next"] != "" {
+		next := links["next"]
+
+		if seen[next] || len(seen) >= maxCatalogPages {
+			return fmt.Errorf("%w: page %s requested twice or too many pages", errPagination, next)
+		}
+
+		seen[next] = true
+
+		page, nextLinks, err := f.fetchCatalogPage(ctx, next)
+		if err != nil {
+			return err
+		}
+
+		maps.Copy(catalog, page)
+
+		// The first page was fetched before following the links.
+		f.progress(len(seen)+1, 0)
+
+		links = nextLinks
+	}
+
+	return nil
+}
+
+// pageURLs enumerates the URLs of the pages from the next to the last one.
+// It returns nil if the page numbers cannot be derived from the links.
+func pageURLs(links map[string]string) []string {
+	next, err := url.Parse(links["next"])
+	if err != nil || links["next"] == "" {
+		return nil
+	}
+
+	last, err := url.Parse(links["last"])
+	if err != nil || links["last"] == "" {
+		return nil
+	}
+
+	nextPage, err := strconv.Atoi(next.Query().Get(pageParam))
+	if err != nil {
+		return nil
+	}
+
+	lastPage, err := strconv.Atoi(last.Query().Get(pageParam))
+	if err != nil || lastPage < nextPage || lastPage-nextPage >= maxCatalogPages {
+		return nil
+	}
+
+	urls := make([]string, 0, lastPage-nextPage+1)
+
+	for page := nextPage; page <= lastPage; page++ {
+		query := next.Query()
+		query.Set(pageParam, strconv.Itoa(page))
+
+		pageURL := *next
+		pageURL.RawQuery = query.Encode()
+
+		urls = append(urls, pageURL.String())
+	}
+
+	return urls
+}
+
+// fetchPagesConcurrently fetches the given pages with at most maxConcurrentPages
+// requests in flight. The first failure cancels the outstanding requests.
+// Pages are merged in order, so later pages win on duplicate names.
+func (f *catalogFetcher) fetchPagesConcurrently(
+	ctx context.Context,
+	catalog map[string]*extension,
+	urls []string,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([]map[string]*extension, len(urls))
+	errs := make([]error, len(urls))
+	sem := make(chan struct{}, maxConcurrentPages)
+
+	var (
+		wg      sync.WaitGroup
+		fetched atomic.Int32
+	)
+
+	for idx, pageURL := range urls {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[idx] = ctx.Err()
+
+				return
+			}
+
+			defer func() { <-sem }()
+
+			page, _, err := f.fetchCatalogPage(ctx, pageURL)
+			if err != nil {
+				errs[idx] = err
+
+				cancel()
+
+				return
+			}
+
+			pages[idx] = page
+
+			// The first page was fetched before the remaining ones.
+			f.progress(int(fetched.Add(1))+1, len(urls)+1)
+		}()
+	}
+
+	wg.Wait()
+
+	if err := firstError(errs); err != nil {
+		return err
+	}
+
+	for _, page := range pages {
+		maps.Copy(catalog, page)
+	}
+
+	return nil
+}
+
+func (f *catalogFetcher) progress(fetched, total int) {
+	if f.onProgress != nil {
+		f.onProgress(fetched, total)
+	}
+}
+
+// firstError returns the first error which is not a consequence of cancellation,
+// or the first error if all of them are.
+func firstError(errs []error) error {
+	var first error
+
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if !errors.Is(err, context.Canceled) {
+			return err
+		}
+
+		if first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// parseLinkHeader parses RFC 8288 Link header values into a map of relation type
+// to absolute URL. Relative references are resolved against base.
+func parseLinkHeader(base *url.URL, values []string) map[string]string {
+	links := make(map[string]string)
+
+	for _, value := range values {
+		for link := range strings.SplitSeq(value, ",") {
+			parts := strings.Split(link, ";")
+
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+
+			ref, err := url.Parse(strings.Trim(target, "<>"))
+			if err != nil {
+				continue
+			}
+
+			if base != nil {
+				ref = base.ResolveReference(ref)
+			}
+
+			for _, param := range parts[1:] {
+				key, val, found := strings.Cut(strings.TrimSpace(param), "=")
+				if !found || !strings.EqualFold(key, "rel") {
+					continue
+				}
+
+				for rel := range strings.FieldsSeq(strings.Trim(val, `"`)) {
+					links[strings.ToLower(rel)] = ref.String()
+				}
+			}
+		}
+	}
+
+	return links
+}
@@ -0,0 +1,157 @@
+package explore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLinkHeader(t *testing.T) {
+	t.Parallel()
+
+	base, err := url.Parse("https://registry.k6.io/v2/catalog.json")
+	require.NoError(t, err)
+
+	links := parseLinkHeader(base, []string{
+		`<https://registry.k6.io/v2/catalog.json?page=2>; rel="next", </v2/catalog.json?page=5>; rel="last"`,
+		`<catalog.json?page=1>; rel="first prev"`,
+		`invalid; rel="ignored"`,
+	})
+
+	require.Equal(t, map[string]string{
+		"next":  "https://registry.k6.io/v2/catalog.json?page=2",
+		"last":  "https://registry.k6.io/v2/catalog.json?page=5",
+		"first": "https://registry.k6.io/v2/catalog.json?page=1",
+		"prev":  "https://registry.k6.io/v2/catalog.json?page=1",
+	}, links)
+}
+
+func TestPageURLs(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t,
+		[]string{"http://host/c?page=2&size=10", "http://host/c?page=3&size=10"},
+		pageURLs(map[string]string{"next": "http://host/c?page=2&size=10", "last": "http://host/c?page=3&size=10"}),
+	)
+
+	require.Nil(t, pageURLs(map[string]string{"next": "http://host/c?page=2"}))
+	require.Nil(t, pageURLs(map[string]string{"next": "http://host/c?cursor=abc", "last": "http://host/c?page=3"}))
+	require.Nil(t, pageURLs(map[string]string{"next": "http://host/c?page=3", "last": "http://host/c?page=2"}))
+	require.Nil(t, pageURLs(map[string]string{}))
+}
+
+// newPagedServer serves one extension per page, advertising the last page only if withLast is set.
+func newPagedServer(t *testing.T, pages int, withLast bool, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			page, _ = strconv.Atoi(p)
+		}
+
+		if page < pages {
+			w.Header().Add("Link", fmt.Sprintf(`</?page=%d>; rel="next"`, page+1))
+		}
+
+		if withLast {
+			w.Header().Add("Link", fmt.Sprintf(`</?page=%d>; rel="last"`, pages))
+		}
+
+		name := fmt.Sprintf("xk6-page%d", page)
+
+		_ = json.NewEncoder(w).Encode(map[string]*extension{
+			name: {Module: "github.com/test/" + name, Versions: []string{"v1.0.0"}},
+		})
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGetExtensionCatalogPagination(t *testing.T) {
+	t.Parallel()
+
+	for _, withLast := range []bool{false, true} {
+		t.Run("last link "+strconv.FormatBool(withLast), func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+
+			server := newPagedServer(t, 7, withLast, &requests)
+
+			var (
+				mu       sync.Mutex
+				progress []int
+				totals   []int
+			)
+
+			fetcher := newCatalogFetcher(nil)
+			fetcher.onProgress = func(fetched, total int) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				progress = append(progress, fetched)
+				totals = append(totals, total)
+			}
+
+			catalog, err := fetcher.getExtensionCatalog(context.Background(), server.URL)
+			require.NoError(t, err)
+			require.Len(t, catalog, 7)
+			require.Equal(t, int32(7), requests.Load())
+			require.Equal(t, "v1.0.0", catalog["xk6-page7"].Latest)
+
+			total := 0
+			if withLast {
+				total = 7
+			}
+
+			require.ElementsMatch(t, []int{2, 3, 4, 5, 6, 7}, progress)
+			require.Equal(t, []int{total, total, total, total, total, total}, totals)
+		})
+	}
+}
+
+func TestGetExtensionCatalogPaginationError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "3" {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Add("Link", `</?page=2>; rel="next", </?page=4>; rel="last"`)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	_, err := newCatalogFetcher(nil).getExtensionCatalog(context.Background(), server.URL)
+	require.ErrorIs(t, err, errFetchExtensionCatalog)
+}
+
+func TestGetExtensionCatalogPaginationLoop(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Add("Link", `</?page=1>; rel="next"`)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	_, err := newCatalogFetcher(nil).getExtensionCatalog(context.Background(), server.URL)
+	require.ErrorIs(t, err, errPagination)
+}
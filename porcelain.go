@@ -0,0 +1,77 @@
+package explore
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+var errInvalidPorcelain = errors.New("invalid --porcelain version: allowed values are v1")
+
+// porcelainV1 is the only version of the porcelain format so far.
+const porcelainV1 porcelainVersion = "v1"
+
+// porcelainVersion is the version of the porcelain format given by the --porcelain flag.
+//
+// Unlike the table, whose layout may change between releases, the porcelain format is
+// stable: within a version, the fields keep their order and meaning, and new fields are
+// only appended at the end of the lines. Any other change comes with a new version, and
+// the previous ones remain available, so wrapper scripts can pin the version they parse.
+type porcelainVersion string
+
+func (v *porcelainVersion) String() string {
+	if v == nil {
+		return ""
+	}
+
+	return string(*v)
+}
+
+func (v *porcelainVersion) Set(s string) error {
+	if porcelainVersion(s) != porcelainV1 {
+		return fmt.Errorf("%w: %q", errInvalidPorcelain, s)
+	}
+
+	*v = porcelainVersion(s)
+
+	return nil
+}
+
+func (v *porcelainVersion) Type() string {
+	return "version"
+}
+
+// outputPorcelain writes the extensions in the porcelain format: a "porcelain v1" line,
+// then a line per extension with the module path, latest version, types, tier, status
+// (deprecated or active) and description, separated by single tabs. Missing values are
+// written as -, and the types are separated by commas.
+func outputPorcelain(gs *state.GlobalState, extensions []*extension) error {
+	_, _ = fmt.Fprintln(gs.Stdout, "porcelain", porcelainV1)
+
+	for _, ext := range extensions {
+		status := "active"
+		if isDeprecated(ext) {
+			status = "deprecated"
+		}
+
+		fields := []string{
+			ext.Module,
+			ext.Latest,
+			strings.Join(extensionKinds(ext), ","),
+			normalizedTier(ext),
+			status,
+			ext.Description,
+		}
+
+		for i, field := range fields {
+			fields[i] = cmp.Or(cleanField(field), "-")
+		}
+
+		_, _ = fmt.Fprintln(gs.Stdout, strings.Join(fields, "\t"))
+	}
+
+	return nil
+}
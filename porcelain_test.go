@@ -0,0 +1,39 @@
+package explore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestOutputPorcelain(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{
+			Module: "github.com/grafana/xk6-faker", Tier: "Official", Latest: "v0.4.4",
+			Imports: []string{"k6/x/faker"}, Outputs: []string{"fake"}, Description: "Fake\tdata\nfor tests",
+		},
+		{Module: "github.com/example/xk6-old", Subcommands: []string{"old"}, Deprecated: true},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, output(options{gs: ts.GlobalState, porcelain: porcelainV1}, extensions, time.Now()))
+	require.Equal(t, "porcelain v1\n"+
+		"github.com/grafana/xk6-faker\tv0.4.4\tjavascript,output\tofficial\tactive\tFake data for tests\n"+
+		"github.com/example/xk6-old\t-\tsubcommand\tcommunity\tdeprecated\t-\n", ts.Stdout.String())
+}
+
+func TestPorcelainVersion(t *testing.T) {
+	t.Parallel()
+
+	var v porcelainVersion
+
+	require.NoError(t, v.Set("v1"))
+	require.Equal(t, porcelainV1, v)
+	require.ErrorIs(t, v.Set("v2"), errInvalidPorcelain)
+	require.ErrorIs(t, v.Set(""), errInvalidPorcelain)
+}
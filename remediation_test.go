@@ -0,0 +1,102 @@
+package explore
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"go.k6.io/k6/v2/errext"
+)
+
+func TestRemediationHint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "forbidden",
+			err:  fmt.Errorf("%w: %w", errFetchExtensionCatalog, &statusError{code: 403, status: "403 Forbidden"}),
+			want: "expired token",
+		},
+		{
+			name: "proxy authentication",
+			err:  &statusError{code: 407, status: "407 Proxy Authentication Required"},
+			want: "HTTPS_PROXY",
+		},
+		{name: "not found", err: &statusError{code: 404, status: "404 Not Found"}, want: "mirror URL"},
+		{name: "rate limited", err: &statusError{code: 429, status: "429 Too Many Requests"}, want: "retry"},
+		{name: "server error", err: &statusError{code: 503, status: "503 Service Unavailable"}, want: "--catalog"},
+		{name: "unexpected status", err: &statusError{code: 418, status: "418 I'm a teapot"}, want: ""},
+		{name: "untrusted certificate", err: fmt.Errorf("get: %w", x509.UnknownAuthorityError{}), want: "CA certificate"},
+		{name: "dns", err: fmt.Errorf("get: %w", &net.DNSError{Err: "no such host"}), want: "cannot be resolved"},
+		{name: "timeout", err: fmt.Errorf("get: %w", &net.OpError{Op: "dial", Err: os.ErrDeadlineExceeded}), want: "in time"},
+		{name: "not a catalog", err: &json.SyntaxError{}, want: "captive portal"},
+		{name: "other", err: errors.New("other"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hint := remediationHint(tt.err)
+			if tt.want == "" {
+				require.Empty(t, hint)
+
+				return
+			}
+
+			require.Contains(t, hint, tt.want)
+		})
+	}
+}
+
+func TestRemediationFetchError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	_, err := newCatalogFetcher(nil).getExtensionCatalog(context.Background(), server.URL)
+	require.EqualError(t, err, "failed to fetch extension catalog: 403 Forbidden")
+
+	err = withRemediation(err)
+	require.ErrorIs(t, err, errFetchExtensionCatalog)
+
+	var hinted errext.HasHint
+
+	require.ErrorAs(t, err, &hinted)
+	require.Contains(t, hinted.Hint(), "credentials")
+
+	require.NoError(t, withRemediation(nil))
+}
+
+func TestAddRemediation(t *testing.T) {
+	t.Parallel()
+
+	root := &cobra.Command{Use: "root"}
+	sub := &cobra.Command{
+		Use:  "sub",
+		RunE: func(_ *cobra.Command, _ []string) error { return &statusError{code: 429, status: "429"} },
+	}
+
+	root.AddCommand(sub)
+	addRemediation(root)
+
+	var hinted errext.HasHint
+
+	require.ErrorAs(t, sub.RunE(sub, nil), &hinted)
+}
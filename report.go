@@ -0,0 +1,128 @@
+package explore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+const (
+	reportJSONFile     = "extensions.json"
+	reportMarkdownFile = "extensions.md"
+	reportHTMLFile     = "index.html"
+	reportStatsFile    = "stats.prom"
+)
+
+// reportHTML is the template of the HTML page of the report.
+//
+//nolint:gochecknoglobals
+var reportHTML = template.Must(template.New(reportHTMLFile).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>k6 extensions</title>
+</head>
+<body>
+<h1>k6 extensions</h1>
+<p>{{len .Extensions}} extensions, fetched at {{.FetchedAt}}.</p>
+<table>
+<thead>
+<tr><th>Module</th><th>Latest</th><th>Type</th><th>Tier</th><th>Description</th></tr>
+</thead>
+<tbody>
+{{- range .Extensions}}
+<tr><td>{{if .Repo}}<a href="{{.Repo.URL}}">{{.Module}}</a>{{else}}{{.Module}}{{end}}</td>` +
+	`<td>{{.Latest}}</td><td>{{.Type}}</td><td>{{.Tier}}</td><td>{{.Description}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// reportRow is an extension as rendered in the HTML page of the report.
+type reportRow struct {
+	*extension
+
+	Type string
+	Tier string
+}
+
+// writeReport writes the report artifacts of the extensions into dir: a JSON snapshot,
+// a Markdown table, an HTML page and the catalog statistics in Prometheus format.
+func writeReport(gs *state.GlobalState, dir string, extensions []*extension, fetchedAt time.Time) error {
+	if err := gs.FS.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+
+	artifacts := map[string]func(w io.Writer) error{
+		reportJSONFile: func(w io.Writer) error {
+			encoder := json.NewEncoder(w)
+			encoder.SetIndent("", "  ")
+
+			return encoder.Encode(toJSONList(extensions))
+		},
+		reportMarkdownFile: func(w io.Writer) error {
+			writeMarkdownTable(w, extensions)
+
+			return nil
+		},
+		reportHTMLFile: func(w io.Writer) error {
+			rows := make([]reportRow, 0, len(extensions))
+			for _, ext := range extensions {
+				rows = append(rows, reportRow{extension: ext, Type: extensionType(ext), Tier: extensionTier(ext)})
+			}
+
+			return reportHTML.Execute(w, map[string]any{
+				"Extensions": rows,
+				"FetchedAt":  fetchedAt.UTC().Format(time.RFC3339),
+			})
+		},
+		reportStatsFile: func(w io.Writer) error {
+			writeProm(w, extensions, fetchedAt)
+
+			return nil
+		},
+	}
+
+	for name, render := range artifacts {
+		var buf bytes.Buffer
+
+		if err := render(&buf); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if err := fsext.WriteFile(gs.FS, filepath.Join(dir, name), buf.Bytes(), 0o600); err != nil {
+			return err
+		}
+	}
+
+	gs.Logger.Infof("Report written to %s", dir)
+
+	return nil
+}
+
+func writeMarkdownTable(w io.Writer, extensions []*extension) {
+	_, _ = fmt.Fprintln(w, "| Module | Latest | Type | Tier | Description |")
+	_, _ = fmt.Fprintln(w, "|--------|--------|------|------|-------------|")
+
+	cell := strings.NewReplacer("|", `\|`, "\n", " ").Replace
+
+	for _, ext := range extensions {
+		module := ext.Module
+		if ext.Repo != nil && ext.Repo.URL != "" {
+			module = "[" + module + "](" + ext.Repo.URL + ")"
+		}
+
+		_, _ = fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n",
+			cell(module), cell(ext.Latest), extensionType(ext), extensionTier(ext), cell(description(ext)))
+	}
+}
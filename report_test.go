@@ -0,0 +1,66 @@
+package explore
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestWriteReport(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	extensions := []*extension{
+		{
+			Module:      "github.com/grafana/xk6-faker",
+			Tier:        "official",
+			Description: "Generate <fake> data | in tests",
+			Latest:      "v0.4.4",
+			Imports:     []string{"k6/x/faker"},
+			Repo:        &repository{URL: "https://github.com/grafana/xk6-faker"},
+		},
+		{
+			Module:  "github.com/example/xk6-output-example",
+			Latest:  "v1.0.0",
+			Outputs: []string{"example"},
+		},
+	}
+
+	dir := filepath.Join("reports", "nightly")
+	fetchedAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, writeReport(ts.GlobalState, dir, extensions, fetchedAt))
+
+	read := func(name string) string {
+		t.Helper()
+
+		data, err := fsext.ReadFile(ts.FS, filepath.Join(dir, name))
+		require.NoError(t, err)
+
+		return string(data)
+	}
+
+	require.Contains(t, read(reportJSONFile), `"module": "github.com/grafana/xk6-faker"`)
+
+	require.Equal(t, `| Module | Latest | Type | Tier | Description |
+|--------|--------|------|------|-------------|
+| [github.com/grafana/xk6-faker](https://github.com/grafana/xk6-faker) | v0.4.4 | JavaScript | Official | Generate <fake> data \| in tests |
+| github.com/example/xk6-output-example | v1.0.0 | Output | Community |  |
+`, read(reportMarkdownFile))
+
+	html := read(reportHTMLFile)
+	require.Contains(t, html, `<p>2 extensions, fetched at 2025-06-01T00:00:00Z.</p>`)
+	require.Contains(t, html, `<a href="https://github.com/grafana/xk6-faker">github.com/grafana/xk6-faker</a>`)
+	require.Contains(t, html, `Generate &lt;fake&gt; data | in tests`)
+
+	var stats bytes.Buffer
+
+	writeProm(&stats, extensions, fetchedAt)
+	require.Equal(t, stats.String(), read(reportStatsFile))
+}
@@ -0,0 +1,223 @@
+package explore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"go.k6.io/k6/v2/cmd/state"
+	"golang.org/x/mod/semver"
+)
+
+var (
+	errInvalidWorkers = errors.New("invalid --workers value: expected a positive number")
+	errInvalidRate    = errors.New("invalid --rate value: expected a number of requests per second between 0 and 1000")
+)
+
+const (
+	// defaultResolveWorkers is the default number of concurrent module proxy requests.
+	defaultResolveWorkers = 8
+	// maxResolveRate is the highest --rate, far above the rate limits of the proxies.
+	maxResolveRate = 1000
+
+	freshnessHeader = "EXTENSION\tCATALOG\tPROXY\tMISSING\tUNLISTED\tSTATUS\n"
+
+	freshUpToDate = "up-to-date"
+	freshBehind   = "behind"
+	freshMissing  = "missing"
+	freshError    = "error"
+)
+
+// freshness compares the versions of an extension in the catalog with the versions
+// known to the Go module proxy.
+type freshness struct {
+	Extension string `json:"extension"`
+	Module    string `json:"module"`
+	// Catalog is the latest version in the catalog.
+	Catalog string `json:"catalog"`
+	// Proxy is the latest version known to the module proxy.
+	Proxy string `json:"proxy"`
+	// Missing lists the catalog versions unknown to the module proxy.
+	Missing []string `json:"missing,omitempty"`
+	// Unlisted lists the module proxy versions missing from the catalog.
+	Unlisted []string `json:"unlisted,omitempty"`
+	// Status is up-to-date, behind (the proxy has a newer version), missing or error.
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// validateResolveLimits checks the --workers and --rate values of --resolve-all-versions.
+func validateResolveLimits(workers int, rps float64) error {
+	if workers < 1 {
+		return fmt.Errorf("%w: %d", errInvalidWorkers, workers)
+	}
+
+	// The negation also rejects NaN.
+	if !(rps >= 0 && rps <= maxResolveRate) {
+		return fmt.Errorf("%w: %v", errInvalidRate, rps)
+	}
+
+	return nil
+}
+
+// runResolveAll reports the freshness matrix of the whole catalog.
+func runResolveAll(opts options, asJSON bool, workers int, rps float64) error {
+	cfg, err := loadConfig(opts.gs)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := loadCatalog(opts, cfg)
+	if err != nil {
+		return err
+	}
+
+	proxy := newGoProxyClient(opts.gs)
+
+	opts.recorder.attach(proxy.client)
+	guardNetwork(opts, proxy.client)
+
+	matrix, err := resolveAllVersions(opts.gs.Ctx, proxy, catalog, workers, rps)
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		return outputJSON(opts.gs, matrix)
+	}
+
+	return outputFreshness(opts.gs, matrix)
+}
+
+// resolveAllVersions reconciles the versions of every extension of the catalog with the
+// module proxy. The core k6 entry is left out, as in the listing. At most workers requests are in flight, and when rps is positive, requests are started
+// at no more than rps per second to stay below the rate limits of the proxy. No request is
+// started once the context is done, and its error is returned.
+func resolveAllVersions(
+	ctx context.Context,
+	proxy *goProxyClient,
+	catalog map[string]*extension,
+	workers int,
+	rps float64,
+) ([]*freshness, error) {
+	names := make([]string, 0, len(catalog))
+	for name, ext := range catalog {
+		if ext.Module != "go.k6.io/k6/v2" {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	var tick <-chan time.Time
+
+	if rps > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / rps))
+		defer ticker.Stop()
+
+		tick = ticker.C
+	}
+
+	matrix := make([]*freshness, len(names))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range max(workers, 1) {
+		wg.Go(func() {
+			for idx := range jobs {
+				matrix[idx] = resolveVersions(ctx, proxy, names[idx], catalog[names[idx]])
+			}
+		})
+	}
+
+dispatch:
+	for idx := range names {
+		if tick != nil {
+			select {
+			case <-tick:
+			case <-ctx.Done():
+				break dispatch
+			}
+		}
+
+		select {
+		case jobs <- idx:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return matrix, nil
+}
+
+func resolveVersions(ctx context.Context, proxy *goProxyClient, name string, ext *extension) *freshness {
+	fresh := &freshness{Extension: name, Module: ext.Module, Catalog: ext.Latest}
+
+	data, err := proxy.get(ctx, ext.Module, "@v/list")
+	if err != nil {
+		fresh.Status = freshError
+		fresh.Error = err.Error()
+
+		return fresh
+	}
+
+	known := strings.Fields(string(data))
+
+	fresh.Proxy = findLatest(known, false)
+
+	for _, v := range ext.Versions {
+		if !slices.Contains(known, v) {
+			fresh.Missing = append(fresh.Missing, v)
+		}
+	}
+
+	for _, v := range known {
+		if !slices.Contains(ext.Versions, v) {
+			fresh.Unlisted = append(fresh.Unlisted, v)
+		}
+	}
+
+	semver.Sort(fresh.Unlisted)
+
+	switch {
+	case len(fresh.Missing) > 0:
+		fresh.Status = freshMissing
+	case fresh.Proxy != "" && semver.Compare(fresh.Proxy, fresh.Catalog) > 0:
+		fresh.Status = freshBehind
+	default:
+		fresh.Status = freshUpToDate
+	}
+
+	return fresh
+}
+
+func outputFreshness(gs *state.GlobalState, matrix []*freshness) error {
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	_, _ = w.Write([]byte(freshnessHeader))
+
+	for _, f := range matrix {
+		status := f.Status
+		if f.Error != "" {
+			status += ": " + f.Error
+		}
+
+		_, _ = w.Write([]byte(f.Extension + "\t" + f.Catalog + "\t" + f.Proxy + "\t" +
+			strings.Join(f.Missing, ",") + "\t" + strings.Join(f.Unlisted, ",") + "\t" + status + "\n"))
+	}
+
+	return w.Flush()
+}
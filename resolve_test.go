@@ -0,0 +1,196 @@
+package explore
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestValidateResolveLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		workers int
+		rps     float64
+		wantErr error
+	}{
+		{name: "defaults", workers: defaultResolveWorkers},
+		{name: "limited", workers: 1, rps: 0.5},
+		{name: "highest rate", workers: 1, rps: maxResolveRate},
+		{name: "no workers", workers: 0, wantErr: errInvalidWorkers},
+		{name: "negative workers", workers: -1, wantErr: errInvalidWorkers},
+		{name: "negative rate", workers: 1, rps: -1, wantErr: errInvalidRate},
+		{name: "rate too high", workers: 1, rps: 2e9, wantErr: errInvalidRate},
+		{name: "infinite rate", workers: 1, rps: math.Inf(1), wantErr: errInvalidRate},
+		{name: "NaN rate", workers: 1, rps: math.NaN(), wantErr: errInvalidRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, validateResolveLimits(tt.workers, tt.rps), tt.wantErr)
+		})
+	}
+}
+
+// newTestGoProxy starts a module proxy serving the version lists, by module path.
+// The proxy answers after the delay, and tracks the number of requests and the
+// highest number of concurrent requests.
+func newTestGoProxy(t *testing.T, lists map[string]string, delay time.Duration) (*goProxyClient, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+
+	var requests, inFlight, maxInFlight atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			highest := maxInFlight.Load()
+			if current <= highest || maxInFlight.CompareAndSwap(highest, current) {
+				break
+			}
+		}
+
+		time.Sleep(delay)
+
+		list, found := lists[strings.TrimSuffix(r.URL.Path, "/@v/list")]
+		if !found {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(list))
+	}))
+	t.Cleanup(srv.Close)
+
+	return &goProxyClient{client: srv.Client(), proxy: srv.URL}, &requests, &maxInFlight
+}
+
+func TestResolveAllVersions(t *testing.T) {
+	t.Parallel()
+
+	proxy, _, _ := newTestGoProxy(t, map[string]string{
+		"/github.com/grafana/xk6-faker":     "v0.4.3\nv0.4.4\n",
+		"/github.com/grafana/xk6-sql":       "v1.0.0\nv1.1.0\nv1.2.0-rc.1\n",
+		"/github.com/grafana/xk6-dashboard": "v0.7.4\n",
+		"/github.com/!example/xk6-kafka":    "v1.0.0\n",
+	}, 0)
+
+	matrix, err := resolveAllVersions(t.Context(), proxy, map[string]*extension{
+		"xk6-faker": {Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4", Versions: []string{"v0.4.4", "v0.4.3"}},
+		"xk6-sql":   {Module: "github.com/grafana/xk6-sql", Latest: "v1.0.0", Versions: []string{"v1.0.0"}},
+		"xk6-dashboard": {
+			Module: "github.com/grafana/xk6-dashboard", Latest: "v0.7.5", Versions: []string{"v0.7.5", "v0.7.4"},
+		},
+		"xk6-kafka":   {Module: "github.com/Example/xk6-kafka", Latest: "v1.0.0", Versions: []string{"v1.0.0"}},
+		"xk6-missing": {Module: "github.com/grafana/xk6-missing", Latest: "v0.1.0", Versions: []string{"v0.1.0"}},
+		"k6":          {Module: "go.k6.io/k6/v2", Latest: "v2.0.0", Versions: []string{"v2.0.0"}},
+	}, defaultResolveWorkers, 0)
+	require.NoError(t, err)
+	require.Len(t, matrix, 5, "the core k6 entry is left out")
+
+	// The matrix is sorted by name, whatever the order the requests complete in.
+	require.Equal(t, &freshness{
+		Extension: "xk6-dashboard", Module: "github.com/grafana/xk6-dashboard",
+		Catalog: "v0.7.5", Proxy: "v0.7.4", Missing: []string{"v0.7.5"}, Status: freshMissing,
+	}, matrix[0])
+	require.Equal(t, &freshness{
+		Extension: "xk6-faker", Module: "github.com/grafana/xk6-faker",
+		Catalog: "v0.4.4", Proxy: "v0.4.4", Status: freshUpToDate,
+	}, matrix[1])
+	require.Equal(t, &freshness{
+		Extension: "xk6-kafka", Module: "github.com/Example/xk6-kafka",
+		Catalog: "v1.0.0", Proxy: "v1.0.0", Status: freshUpToDate,
+	}, matrix[2])
+	require.Equal(t, "xk6-missing", matrix[3].Extension)
+	require.Equal(t, freshError, matrix[3].Status)
+	require.Contains(t, matrix[3].Error, "404")
+	require.Equal(t, &freshness{
+		Extension: "xk6-sql", Module: "github.com/grafana/xk6-sql",
+		Catalog: "v1.0.0", Proxy: "v1.1.0", Unlisted: []string{"v1.1.0", "v1.2.0-rc.1"}, Status: freshBehind,
+	}, matrix[4])
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputFreshness(ts.GlobalState, matrix[1:2]))
+	require.Equal(t, `EXTENSION  CATALOG  PROXY   MISSING  UNLISTED  STATUS
+xk6-faker  v0.4.4   v0.4.4                     up-to-date
+`, ts.Stdout.String())
+}
+
+// testResolveCatalog returns a catalog of n extensions, all known to the proxy.
+func testResolveCatalog(n int) (map[string]*extension, map[string]string) {
+	catalog := make(map[string]*extension, n)
+	lists := make(map[string]string, n)
+
+	for i := range n {
+		module := fmt.Sprintf("github.com/grafana/xk6-%d", i)
+
+		catalog[fmt.Sprintf("xk6-%d", i)] = &extension{Module: module, Latest: "v1.0.0", Versions: []string{"v1.0.0"}}
+		lists["/"+module] = "v1.0.0\n"
+	}
+
+	return catalog, lists
+}
+
+func TestResolveAllVersionsWorkers(t *testing.T) {
+	t.Parallel()
+
+	catalog, lists := testResolveCatalog(12)
+	proxy, requests, maxInFlight := newTestGoProxy(t, lists, 20*time.Millisecond)
+
+	matrix, err := resolveAllVersions(t.Context(), proxy, catalog, 3, 0)
+	require.NoError(t, err)
+	require.Len(t, matrix, 12)
+	require.EqualValues(t, 12, requests.Load())
+	require.LessOrEqual(t, maxInFlight.Load(), int32(3))
+	require.Greater(t, maxInFlight.Load(), int32(1))
+}
+
+func TestResolveAllVersionsRate(t *testing.T) {
+	t.Parallel()
+
+	catalog, lists := testResolveCatalog(5)
+	proxy, requests, _ := newTestGoProxy(t, lists, 0)
+
+	started := time.Now()
+
+	matrix, err := resolveAllVersions(t.Context(), proxy, catalog, defaultResolveWorkers, 50)
+	require.NoError(t, err)
+	require.Len(t, matrix, 5)
+	require.EqualValues(t, 5, requests.Load())
+
+	// A request is started on each tick, every 20ms.
+	require.GreaterOrEqual(t, time.Since(started), 100*time.Millisecond)
+}
+
+func TestResolveAllVersionsCanceled(t *testing.T) {
+	t.Parallel()
+
+	catalog, lists := testResolveCatalog(20)
+	proxy, requests, _ := newTestGoProxy(t, lists, 0)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	// At 10 requests per second, the first request would be started after the deadline.
+	matrix, err := resolveAllVersions(ctx, proxy, catalog, defaultResolveWorkers, 10)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Nil(t, matrix)
+	require.Zero(t, requests.Load())
+}
@@ -0,0 +1,104 @@
+package explore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+const (
+	// reuseFetchEnvVar sets the --reuse-fetch token for a whole CI job, e.g. to the job ID.
+	reuseFetchEnvVar = "K6_EXPLORE_REUSE_FETCH"
+
+	// reuseMaxAge is the age from which memos of other tokens are removed.
+	reuseMaxAge = 24 * time.Hour
+)
+
+// fetchMemo is a catalog fetched from the registry, kept for the later invocations
+// sharing the same --reuse-fetch token.
+type fetchMemo struct {
+	URL       string                `json:"url"`
+	FetchedAt time.Time             `json:"fetchedAt"`
+	Catalog   map[string]*extension `json:"catalog"`
+}
+
+// reuseFetchToken returns the --reuse-fetch token, or the value of the environment variable.
+func reuseFetchToken(opts options) string {
+	if opts.reuseFetch != "" {
+		return opts.reuseFetch
+	}
+
+	return opts.gs.Env[reuseFetchEnvVar]
+}
+
+// fetchMemoPath returns the memo file of the token and catalog URL.
+func fetchMemoPath(gs *state.GlobalState, token string, url string) string {
+	sum := sha256.Sum256([]byte(token + "\x00" + url))
+
+	return filepath.Join(gs.UserOSConfigDir, "k6", "explore", "fetches", hex.EncodeToString(sum[:8])+".json")
+}
+
+// fetchReusable returns the registry catalog from the memo of the token, fetching and
+// memoizing it on the first invocation. A corrupt memo is fetched again.
+func fetchReusable(opts options, token string, url string) (map[string]*extension, error) {
+	path := fetchMemoPath(opts.gs, token, url)
+
+	data, err := fsext.ReadFile(opts.gs.FS, path)
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		var memo fetchMemo
+
+		if err := json.Unmarshal(data, &memo); err == nil && memo.URL == url && memo.Catalog != nil {
+			opts.gs.Logger.Debugf("Reusing the catalog fetched at %s", memo.FetchedAt.Format(time.RFC3339))
+			opts.summary.fetchedFrom(sourceRegistry, url, cacheHit, len(memo.Catalog))
+
+			return memo.Catalog, nil
+		}
+	}
+
+	catalog, err := newFetcher(opts).getExtensionCatalog(opts.gs.Ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.summary.fetchedFrom(sourceRegistry, url, cacheMiss, len(catalog))
+
+	data, err = json.Marshal(&fetchMemo{URL: url, FetchedAt: time.Now(), Catalog: catalog})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := opts.gs.FS.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+
+	pruneFetchMemos(opts.gs, filepath.Dir(path))
+
+	return catalog, fsext.WriteFile(opts.gs.FS, path, data, 0o600)
+}
+
+// pruneFetchMemos removes the memos of former CI jobs. Errors are ignored, stale memos
+// are only wasted disk space.
+func pruneFetchMemos(gs *state.GlobalState, dir string) {
+	entries, err := fsext.ReadDir(gs.FS, dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() && time.Since(entry.ModTime()) > reuseMaxAge {
+			_ = gs.FS.Remove(filepath.Join(dir, entry.Name()))
+		}
+	}
+}
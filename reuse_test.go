@@ -0,0 +1,84 @@
+package explore
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestFetchReusable(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+
+		_, _ = w.Write([]byte(`{"xk6-faker":{"module":"github.com/grafana/xk6-faker","versions":["v0.4.4"]}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	ts := cmdtests.NewGlobalTestState(t)
+	opts := options{gs: ts.GlobalState}
+
+	for range 3 {
+		catalog, err := fetchReusable(opts, "job-1", srv.URL)
+		require.NoError(t, err)
+		require.Equal(t, "v0.4.4", catalog["xk6-faker"].Latest)
+	}
+
+	require.Equal(t, int32(1), requests.Load(), "the catalog must be fetched once per token")
+
+	_, err := fetchReusable(opts, "job-2", srv.URL)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), requests.Load(), "another token must fetch the catalog again")
+
+	// A corrupt memo is fetched again.
+	require.NoError(t, fsext.WriteFile(ts.FS, fetchMemoPath(ts.GlobalState, "job-1", srv.URL), []byte("{"), 0o600))
+
+	_, err = fetchReusable(opts, "job-1", srv.URL)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), requests.Load())
+}
+
+func TestPruneFetchMemos(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+	stale := fetchMemoPath(ts.GlobalState, "old-job", "https://registry.k6.io/v2/catalog.json")
+	fresh := fetchMemoPath(ts.GlobalState, "new-job", "https://registry.k6.io/v2/catalog.json")
+
+	require.NoError(t, fsext.WriteFile(ts.FS, stale, []byte("{}"), 0o600))
+	require.NoError(t, fsext.WriteFile(ts.FS, fresh, []byte("{}"), 0o600))
+	require.NoError(t, ts.FS.Chtimes(stale, time.Now().Add(-2*reuseMaxAge), time.Now().Add(-2*reuseMaxAge)))
+
+	pruneFetchMemos(ts.GlobalState, filepath.Dir(fresh))
+
+	exists, err := fsext.Exists(ts.FS, stale)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = fsext.Exists(ts.FS, fresh)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestReuseFetchToken(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.Empty(t, reuseFetchToken(options{gs: ts.GlobalState}))
+
+	ts.Env[reuseFetchEnvVar] = "from-env"
+
+	require.Equal(t, "from-env", reuseFetchToken(options{gs: ts.GlobalState}))
+	require.Equal(t, "from-flag", reuseFetchToken(options{gs: ts.GlobalState, reuseFetch: "from-flag"}))
+}
@@ -0,0 +1,257 @@
+package explore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+)
+
+const (
+	// mediaTypeCatalogV1 is served by registries which predate schema negotiation:
+	// a JSON object mapping extension names to extension records.
+	mediaTypeCatalogV1 = "application/json"
+
+	// mediaTypeCatalogV2 is the v2 catalog schema: a versioned document with
+	// an array of extensions carrying nested capabilities and products.
+	mediaTypeCatalogV2 = "application/vnd.k6.catalog.v2+json"
+
+	// catalogAccept prefers the v2 schema but keeps accepting plain JSON.
+	catalogAccept = mediaTypeCatalogV2 + ", " + mediaTypeCatalogV1 + ";q=0.9"
+)
+
+// catalogDecoder decodes a registry response body into the internal extension model,
+// keyed by extension name. Entries are decoded one by one: an invalid entry is passed
+// to onInvalid and skipped, or fails the decoding if onInvalid is nil.
+type catalogDecoder func(r io.Reader, onInvalid invalidEntryFunc) (map[string]*extension, error)
+
+// catalogDecoders contains the supported catalog schemas keyed by media type.
+// Register a new decoder here and prepend its media type to catalogAccept to support
+// a new schema version.
+//
+//nolint:gochecknoglobals
+var catalogDecoders = map[string]catalogDecoder{
+	mediaTypeCatalogV1: decodeCatalogV1,
+	mediaTypeCatalogV2: decodeCatalogV2,
+}
+
+// decoderFor returns the decoder for the given Content-Type header value.
+// Missing, malformed or unknown content types fall back to the v1 schema.
+func decoderFor(contentType string) catalogDecoder {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return decodeCatalogV1
+	}
+
+	if decoder, found := catalogDecoders[mediaType]; found {
+		return decoder
+	}
+
+	return decodeCatalogV1
+}
+
+var (
+	errInvalidEntry  = errors.New("invalid catalog entry")
+	errEmptyEntry    = errors.New("empty entry")
+	errMissingModule = errors.New("missing module")
+)
+
+func decodeCatalogV1(r io.Reader, onInvalid invalidEntryFunc) (map[string]*extension, error) {
+	var entries map[string]json.RawMessage
+
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+
+	catalog := make(map[string]*extension, len(entries))
+
+	for name, entry := range entries {
+		ext := new(extension)
+
+		err := decodeEntry(entry, ext, func() error { return validateEntry(ext) })
+		if err != nil {
+			if onInvalid == nil {
+				return nil, fmt.Errorf("%w %s: %w", errInvalidEntry, name, err)
+			}
+
+			onInvalid(name, err)
+
+			continue
+		}
+
+		catalog[name] = ext
+	}
+
+	return catalog, nil
+}
+
+// decodeEntry decodes a single catalog entry into target and validates it.
+func decodeEntry(entry json.RawMessage, target any, validate func() error) error {
+	if len(entry) == 0 || string(entry) == "null" {
+		return errEmptyEntry
+	}
+
+	if err := json.Unmarshal(entry, target); err != nil {
+		return err
+	}
+
+	return validate()
+}
+
+func validateEntry(ext *extension) error {
+	if ext.Module == "" {
+		return errMissingModule
+	}
+
+	return nil
+}
+
+const catalogSchemaV2 = 2
+
+type catalogV2 struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	Extensions    []json.RawMessage `json:"extensions"`
+}
+
+type extensionV2 struct {
+	Name         string         `json:"name"`
+	Module       string         `json:"module"`
+	Tier         string         `json:"tier,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Versions     []string       `json:"versions,omitempty"`
+	Constraints  string         `json:"constraints,omitempty"`
+	Capabilities capabilitiesV2 `json:"capabilities"`
+	Products     []productV2    `json:"products,omitempty"`
+	Categories   []string       `json:"categories,omitempty"`
+	Deprecated   bool           `json:"deprecated,omitempty"`
+	Deprecation  *deprecation   `json:"deprecation,omitempty"`
+	Cgo          bool           `json:"cgo,omitempty"`
+	Platforms    []string       `json:"platforms,omitempty"`
+	Repo         *repository    `json:"repo,omitempty"`
+}
+
+// capabilitiesV2 maps the extension points to their names, e.g. imports to k6/x/faker.
+// Extension points unknown to this release become kinds of their own, so new k6 extension
+// types can be listed and filtered without a release. Values other than a list of names
+// are ignored.
+type capabilitiesV2 map[string]json.RawMessage
+
+const (
+	capabilityImports     = "imports"
+	capabilityOutputs     = "outputs"
+	capabilitySubcommands = "subcommands"
+)
+
+// names returns the names of the extension point, or nil if missing or not a list of names.
+func (c capabilitiesV2) names(key string) []string {
+	var names []string
+
+	if err := json.Unmarshal(c[key], &names); err != nil {
+		return nil
+	}
+
+	return names
+}
+
+// others returns the extension points other than imports, outputs and subcommands.
+func (c capabilitiesV2) others() map[string][]string {
+	var others map[string][]string
+
+	for key := range c {
+		if key == capabilityImports || key == capabilityOutputs || key == capabilitySubcommands {
+			continue
+		}
+
+		if names := c.names(key); len(names) > 0 {
+			if others == nil {
+				others = make(map[string][]string)
+			}
+
+			others[key] = names
+		}
+	}
+
+	return others
+}
+
+type productV2 struct {
+	Name string `json:"name"`
+}
+
+func decodeCatalogV2(r io.Reader, onInvalid invalidEntryFunc) (map[string]*extension, error) {
+	var doc catalogV2
+
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	if doc.SchemaVersion != catalogSchemaV2 {
+		return nil, fmt.Errorf("%w: unsupported schema version %d", errFetchExtensionCatalog, doc.SchemaVersion)
+	}
+
+	catalog := make(map[string]*extension, len(doc.Extensions))
+
+	for idx, entry := range doc.Extensions {
+		ext, err := decodeExtensionV2(entry)
+		if err != nil {
+			// The name may be missing or invalid, the position identifies the entry.
+			name := fmt.Sprintf("#%d", idx)
+			if ext.Name != "" {
+				name = ext.Name
+			}
+
+			if onInvalid == nil {
+				return nil, fmt.Errorf("%w %s: %w", errInvalidEntry, name, err)
+			}
+
+			onInvalid(name, err)
+
+			continue
+		}
+
+		name := ext.Name
+		if name == "" {
+			name = ext.Module
+		}
+
+		catalog[name] = ext.toExtension()
+	}
+
+	return catalog, nil
+}
+
+// decodeExtensionV2 decodes and validates a v2 extension record, an entry of the catalog
+// or the response of the per-extension endpoint.
+func decodeExtensionV2(entry json.RawMessage) (*extensionV2, error) {
+	ext := new(extensionV2)
+
+	return ext, decodeEntry(entry, ext, func() error { return validateEntry(&extension{Module: ext.Module}) })
+}
+
+// toExtension maps the v2 record into the internal extension model.
+func (ext *extensionV2) toExtension() *extension {
+	products := make([]string, 0, len(ext.Products))
+	for _, product := range ext.Products {
+		products = append(products, product.Name)
+	}
+
+	return &extension{
+		Module:       ext.Module,
+		Tier:         ext.Tier,
+		Description:  ext.Description,
+		Versions:     ext.Versions,
+		Imports:      ext.Capabilities.names(capabilityImports),
+		Outputs:      ext.Capabilities.names(capabilityOutputs),
+		Subcommands:  ext.Capabilities.names(capabilitySubcommands),
+		Products:     products,
+		Categories:   ext.Categories,
+		Capabilities: ext.Capabilities.others(),
+		Constraints:  ext.Constraints,
+		Deprecated:   ext.Deprecated,
+		Deprecation:  ext.Deprecation,
+		Cgo:          ext.Cgo,
+		Platforms:    ext.Platforms,
+		Repo:         ext.Repo,
+	}
+}
@@ -0,0 +1,167 @@
+package explore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testCatalogV2 = `{
+  "schemaVersion": 2,
+  "extensions": [
+    {
+      "name": "xk6-faker",
+      "module": "github.com/grafana/xk6-faker",
+      "tier": "official",
+      "description": "Generate fake data",
+      "versions": ["v0.4.3", "v0.4.4"],
+      "capabilities": {"imports": ["k6/x/faker"]},
+      "products": [{"name": "oss"}, {"name": "cloud"}],
+      "categories": ["data"],
+      "repo": {"url": "https://github.com/grafana/xk6-faker"}
+    },
+    {
+      "module": "github.com/grafana/xk6-dashboard",
+      "capabilities": {"subcommands": ["dashboard"]}
+    }
+  ]
+}`
+
+func TestDecoderFor(t *testing.T) {
+	t.Parallel()
+
+	v2 := strings.NewReader(testCatalogV2)
+	v1 := `{"xk6-faker":{"module":"github.com/grafana/xk6-faker"}}`
+
+	catalog, err := decoderFor(mediaTypeCatalogV2+"; charset=utf-8")(v2, nil)
+	require.NoError(t, err)
+	require.Len(t, catalog, 2)
+
+	for _, contentType := range []string{"application/json", "text/plain; charset=utf-8", "", ";invalid"} {
+		catalog, err := decoderFor(contentType)(strings.NewReader(v1), nil)
+		require.NoError(t, err, contentType)
+		require.Contains(t, catalog, "xk6-faker", contentType)
+	}
+}
+
+func TestDecodeCatalogV2(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := decodeCatalogV2(strings.NewReader(testCatalogV2), nil)
+	require.NoError(t, err)
+
+	faker := catalog["xk6-faker"]
+	require.NotNil(t, faker)
+	require.Equal(t, "github.com/grafana/xk6-faker", faker.Module)
+	require.Equal(t, "official", faker.Tier)
+	require.Equal(t, []string{"k6/x/faker"}, faker.Imports)
+	require.Equal(t, []string{"oss", "cloud"}, faker.Products)
+	require.Equal(t, []string{"data"}, faker.Categories)
+	require.Equal(t, "https://github.com/grafana/xk6-faker", faker.Repo.URL)
+
+	// Extensions without a name are keyed by module path.
+	dashboard := catalog["github.com/grafana/xk6-dashboard"]
+	require.NotNil(t, dashboard)
+	require.Equal(t, []string{"dashboard"}, dashboard.Subcommands)
+	require.Nil(t, dashboard.Capabilities)
+
+	// Extension points unknown to this release are kept as capabilities, other values are ignored.
+	catalog, err = decodeCatalogV2(strings.NewReader(`{"schemaVersion": 2, "extensions": [{
+		"name": "xk6-vault", "module": "github.com/example/xk6-vault", "cgo": true,
+		"capabilities": {"imports": ["k6/x/vault"], "secret-source": ["vault"], "limits": {"max": 1}}
+	}]}`), nil)
+	require.NoError(t, err)
+	require.True(t, catalog["xk6-vault"].Cgo)
+	require.Equal(t, []string{"k6/x/vault"}, catalog["xk6-vault"].Imports)
+	require.Equal(t, map[string][]string{"secret-source": {"vault"}}, catalog["xk6-vault"].Capabilities)
+
+	_, err = decodeCatalogV2(strings.NewReader(`{"schemaVersion": 3, "extensions": []}`), nil)
+	require.ErrorIs(t, err, errFetchExtensionCatalog)
+}
+
+func TestGetExtensionCatalogNegotiation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Accept"), mediaTypeCatalogV2) {
+			http.Error(w, "v2 not accepted", http.StatusNotAcceptable)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", mediaTypeCatalogV2)
+		_, _ = w.Write([]byte(testCatalogV2))
+	}))
+	defer server.Close()
+
+	catalog, err := newCatalogFetcher(nil).getExtensionCatalog(context.Background(), server.URL)
+	require.NoError(t, err)
+	require.Len(t, catalog, 2)
+	require.Equal(t, "v0.4.4", catalog["xk6-faker"].Latest)
+}
+
+func TestDecodeCatalogLenient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		decoder catalogDecoder
+		body    string
+		valid   []string
+		invalid []string
+	}{
+		{
+			name:    "v1",
+			decoder: decodeCatalogV1,
+			body: `{
+				"xk6-faker": {"module": "github.com/grafana/xk6-faker", "versions": ["v0.4.4"]},
+				"xk6-bad-versions": {"module": "github.com/test/xk6-bad", "versions": "v1.0.0"},
+				"xk6-null": null,
+				"xk6-no-module": {"description": "no module"}
+			}`,
+			valid:   []string{"xk6-faker"},
+			invalid: []string{"xk6-bad-versions", "xk6-null", "xk6-no-module"},
+		},
+		{
+			name:    "v2",
+			decoder: decodeCatalogV2,
+			body: `{"schemaVersion": 2, "extensions": [
+				{"name": "xk6-faker", "module": "github.com/grafana/xk6-faker"},
+				{"name": "xk6-bad-capabilities", "module": "github.com/test/xk6-bad", "capabilities": []},
+				{"description": "neither name nor module"}
+			]}`,
+			valid:   []string{"xk6-faker"},
+			invalid: []string{"xk6-bad-capabilities", "#2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var invalid []string
+
+			catalog, err := tt.decoder(strings.NewReader(tt.body), func(name string, err error) {
+				require.Error(t, err)
+
+				invalid = append(invalid, name)
+			})
+			require.NoError(t, err)
+			require.Len(t, catalog, len(tt.valid))
+
+			for _, name := range tt.valid {
+				require.Contains(t, catalog, name)
+			}
+
+			require.ElementsMatch(t, tt.invalid, invalid)
+
+			// Without a handler the first invalid entry fails the decoding.
+			_, err = tt.decoder(strings.NewReader(tt.body), nil)
+			require.ErrorIs(t, err, errInvalidEntry)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package explore
+
+import "strings"
+
+// searchCatalog returns the extensions of the catalog matching any of the search terms.
+func searchCatalog(catalog map[string]*extension, terms []string, caseSensitive bool) map[string]*extension {
+	found := make(map[string]*extension)
+
+	for name, ext := range catalog {
+		if matchesAnyTerm(name, ext, terms, caseSensitive) {
+			found[name] = ext
+		}
+	}
+
+	return found
+}
+
+// matchesAnyTerm reports whether any of the terms is contained in the name, module path,
+// description, imports, outputs or subcommands of the extension.
+func matchesAnyTerm(name string, ext *extension, terms []string, caseSensitive bool) bool {
+	fields := make([]string, 0, 3+len(ext.Imports)+len(ext.Outputs)+len(ext.Subcommands)) //nolint:mnd
+
+	fields = append(fields, name, ext.Module, ext.Description)
+	fields = append(fields, ext.Imports...)
+	fields = append(fields, ext.Outputs...)
+	fields = append(fields, ext.Subcommands...)
+
+	contains := func(s, substr string) bool {
+		return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+	}
+
+	if caseSensitive {
+		contains = strings.Contains
+	}
+
+	for _, term := range terms {
+		for _, field := range fields {
+			if contains(field, term) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
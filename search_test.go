@@ -0,0 +1,65 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker": {
+			Module:      "github.com/grafana/xk6-faker",
+			Description: "Generate fake data in your tests",
+			Imports:     []string{"k6/x/faker"},
+		},
+		"xk6-kafka": {
+			Module:      "github.com/mostafa/xk6-kafka",
+			Description: "Load-test Apache Kafka",
+			Imports:     []string{"k6/x/kafka"},
+		},
+		"xk6-dashboard": {
+			Module:      "github.com/grafana/xk6-dashboard",
+			Description: "Web-based metrics dashboard",
+			Subcommands: []string{"dashboard"},
+		},
+		"xk6-output-influxdb": {
+			Module:  "github.com/grafana/xk6-output-influxdb",
+			Outputs: []string{"xk6-influxdb"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		terms         []string
+		caseSensitive bool
+		want          []string
+	}{
+		{name: "by name", terms: []string{"faker"}, want: []string{"xk6-faker"}},
+		{name: "any term", terms: []string{"faker", "kafka"}, want: []string{"xk6-faker", "xk6-kafka"}},
+		{name: "case-insensitive description", terms: []string{"APACHE"}, want: []string{"xk6-kafka"}},
+		{name: "module owner", terms: []string{"mostafa"}, want: []string{"xk6-kafka"}},
+		{name: "subcommand", terms: []string{"dashboard"}, want: []string{"xk6-dashboard"}},
+		{name: "output", terms: []string{"influx"}, want: []string{"xk6-output-influxdb"}},
+		{name: "no match", terms: []string{"grpc"}, want: []string{}},
+		{name: "case-sensitive", terms: []string{"APACHE", "Kafka"}, caseSensitive: true, want: []string{"xk6-kafka"}},
+		{name: "case-sensitive no match", terms: []string{"FAKER"}, caseSensitive: true, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			found := searchCatalog(catalog, tt.terms, tt.caseSensitive)
+
+			names := make([]string, 0, len(found))
+			for name := range found {
+				names = append(names, name)
+			}
+
+			require.ElementsMatch(t, tt.want, names)
+		})
+	}
+}
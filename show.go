@@ -0,0 +1,437 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/fatih/color"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+const (
+	showHelpShort = "Show the details of extensions"
+	showHelpLong  = `Show the details of one or more extensions.
+
+The extensions can be referenced by catalog name (xk6-faker), module path
+(github.com/grafana/xk6-faker) or JavaScript import path (k6/x/faker).
+With --all-matching, the extensions whose module path or description match
+a regular expression are shown instead. The details of several extensions
+are separated by a line.
+
+With --json, the extensions are output in the object of the --json-meta
+list output, whatever their number: the schemaVersion of the format, where
+the data came from and the extensions array.
+
+The details include the description, repository URL, tier, all versions,
+imports, outputs, subcommands, categories, k6 version constraints and the
+last repository update, in the local time zone unless --utc is given.
+
+With --history, the changes of the latest version and of the k6 version
+constraints are listed from the catalog snapshots written by the export
+subcommand into a directory (the current directory by default). This helps
+finding the last extension version compatible with an older k6 release.
+`
+	showHelpExample = `
+# Show an extension by name:
+k6 x explore show xk6-faker
+
+# Show an extension by import path:
+k6 x explore show k6/x/faker
+
+# Output as JSON:
+k6 x explore show xk6-faker --json
+
+# Compare a few candidates:
+k6 x explore show xk6-sql xk6-sql-driver-mysql xk6-sql-driver-postgres
+
+# Show all the extensions related to Kafka:
+k6 x explore show --all-matching kafka
+
+# Show the constraint history from the snapshots in the snapshots directory:
+k6 x explore show xk6-faker --history=snapshots
+`
+
+	none = "-"
+
+	// showSeparatorWidth is the maximum width of the line separating the extensions.
+	showSeparatorWidth = 80
+)
+
+var errShowArgs = errors.New("expected one or more extensions, or --all-matching")
+
+// newShowCommand creates the "show" subcommand of explore.
+func newShowCommand(opts *options) *cobra.Command {
+	var (
+		asJSON   bool
+		history  string
+		matching matchPattern
+	)
+
+	cmd := &cobra.Command{
+		Use:     "show <name>...",
+		Short:   showHelpShort,
+		Long:    showHelpLong,
+		Example: showHelpExample,
+		Args: func(_ *cobra.Command, args []string) error {
+			switch {
+			case matching.re != nil && len(args) > 0, matching.re == nil && len(args) == 0:
+				return errShowArgs
+			case history != "" && len(args) != 1:
+				return fmt.Errorf("%w: --history takes a single extension", errShowArgs)
+			default:
+				return nil
+			}
+		},
+		RunE: func(_ *cobra.Command, args []string) error {
+			opts.summary = newRunSummary(opts.gs, asJSON)
+
+			if matching.re != nil || len(args) > 1 {
+				return showExtensions(*opts, args, &matching, asJSON)
+			}
+
+			if history != "" {
+				entries, err := loadHistory(opts.gs, history, args[0])
+				if err != nil {
+					return err
+				}
+
+				if asJSON {
+					return outputJSON(opts.gs, entries)
+				}
+
+				dates, err := newDateFormat(*opts)
+				if err != nil {
+					return err
+				}
+
+				return outputHistory(opts.gs, entries, dates)
+			}
+
+			name, ext, err := loadExtension(*opts, args[0])
+			if err != nil {
+				return err
+			}
+
+			if asJSON {
+				return outputShowJSON(*opts, []*extension{ext})
+			}
+
+			dates, err := newDateFormat(*opts)
+			if err != nil {
+				return err
+			}
+
+			return outputShow(opts.gs, name, ext, dates, newNumberFormat(*opts))
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.BoolVar(&asJSON, "json", false, "output in JSON format")
+	flags.StringVar(&history, "history", "",
+		"show how the latest version and the k6 constraints changed across the catalog snapshots in a directory")
+	flags.Lookup("history").NoOptDefVal = "."
+	flags.Var(&matching, "all-matching",
+		"show all the extensions whose module path or description match a regular expression")
+
+	return cmd
+}
+
+// showExtensions shows the details of several extensions, looked up in a single catalog
+// fetch, or of all the extensions matching the pattern.
+func showExtensions(opts options, queries []string, matching *matchPattern, asJSON bool) error {
+	cfg, err := loadConfig(opts.gs)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := loadCatalog(opts, cfg)
+	if err != nil {
+		return err
+	}
+
+	opts.summary.loaded(len(filterExtensions(catalog)))
+
+	names, err := selectShown(catalog, queries, matching)
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		extensions := make([]*extension, 0, len(names))
+		for _, name := range names {
+			extensions = append(extensions, catalog[name])
+		}
+
+		return outputShowJSON(opts, extensions)
+	}
+
+	dates, err := newDateFormat(opts)
+	if err != nil {
+		return err
+	}
+
+	return outputShowAll(opts.gs, catalog, names, dates, newNumberFormat(opts))
+}
+
+// outputShowJSON writes the extensions in the object of the --json-meta list output, so
+// that the shape does not depend on the number of extensions and carries the schemaVersion.
+func outputShowJSON(opts options, extensions []*extension) error {
+	return outputJSON(opts.gs, newJSONMeta(opts.summary, time.Now(), toJSONList(extensions)))
+}
+
+// selectShown returns the catalog names of the extensions to show: those matching the
+// pattern, sorted by module path, or those referenced by the queries, in the given order.
+func selectShown(catalog map[string]*extension, queries []string, matching *matchPattern) ([]string, error) {
+	var names []string
+
+	if matching.re != nil {
+		pattern := matching.String()
+
+		// Like --match without --case-sensitive.
+		matching.ignoreCase()
+
+		for name, ext := range catalog {
+			if ext.Module != "go.k6.io/k6/v2" && matching.filter(ext) {
+				names = append(names, name)
+			}
+		}
+
+		if len(names) == 0 {
+			return nil, fmt.Errorf("%w: no match for %s", errExtensionNotFound, pattern)
+		}
+
+		sort.Slice(names, func(i, j int) bool { return catalog[names[i]].Module < catalog[names[j]].Module })
+	}
+
+	for _, query := range queries {
+		name, ext := findExtension(catalog, query)
+		if ext == nil {
+			return nil, fmt.Errorf("%w: %s", errExtensionNotFound, query)
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// outputShowAll writes the details of the extensions, separated by a line.
+func outputShowAll(
+	gs *state.GlobalState,
+	catalog map[string]*extension,
+	names []string,
+	dates dateFormat,
+	numbers numberFormat,
+) error {
+	separator := strings.Repeat("-", min(getTerminalWidth(gs), showSeparatorWidth))
+
+	for i, name := range names {
+		if i > 0 {
+			_, _ = fmt.Fprintf(gs.Stdout, "\n%s\n\n", separator)
+		}
+
+		if err := outputShow(gs, name, catalog[name], dates, numbers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadExtension looks up a single extension by catalog name, module path or import path.
+// Plain names are first fetched from the per-extension registry endpoint, falling back to
+// the whole catalog when the registry does not advertise the endpoint, the catalog source
+// is a snapshot or the fetched catalog is reused (--reuse-fetch). The fetched extension
+// goes through the same checks as the catalog: invalid records are skipped, or fail with
+// --strict, and the notes are merged.
+func loadExtension(opts options, query string) (string, *extension, error) {
+	cfg, err := loadConfig(opts.gs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !strings.Contains(query, "/") && opts.catalog == "" && reuseFetchToken(opts) == "" {
+		ext, err := newFetcher(opts).getExtension(opts.gs.Ctx, catalogURL(opts), query)
+
+		switch {
+		case err == nil:
+			if err := prepareCatalog(opts, cfg, map[string]*extension{query: ext}); err != nil {
+				return "", nil, err
+			}
+
+			opts.summary.fetchedFrom(sourceRegistry, catalogURL(opts), "", 1)
+			opts.summary.loaded(1)
+
+			return query, ext, nil
+		case errors.Is(err, errInvalidEntry) && opts.strict:
+			return "", nil, err
+		case errors.Is(err, errInvalidEntry):
+			return "", nil, fmt.Errorf("%w: %s", errExtensionNotFound, query)
+		}
+
+		opts.gs.Logger.Debugf("Per-extension endpoint unavailable, fetching the catalog: %v", err)
+	}
+
+	catalog, err := loadCatalog(opts, cfg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	opts.summary.loaded(len(filterExtensions(catalog)))
+
+	name, ext := findExtension(catalog, query)
+	if ext == nil {
+		return "", nil, fmt.Errorf("%w: %s", errExtensionNotFound, query)
+	}
+
+	return name, ext, nil
+}
+
+// findExtension finds an extension by catalog name, module path or JavaScript import path.
+// When several extensions declare the import path, the first catalog name in sort order wins,
+// as in lookupExtension.
+func findExtension(catalog map[string]*extension, query string) (string, *extension) {
+	if name, ext := lookupExtension(catalog, query); ext != nil {
+		return name, ext
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(catalog)) {
+		if slices.Contains(catalog[name].Imports, query) {
+			return name, catalog[name]
+		}
+	}
+
+	return "", nil
+}
+
+// outputShow writes the details of the extension, with the update time and the counts
+// in the given formats.
+func outputShow(gs *state.GlobalState, name string, ext *extension, dates dateFormat, numbers numberFormat) error {
+	heading := color.New(color.Bold).SprintFunc()
+	link := color.New(color.FgBlue, color.Underline).SprintFunc()
+
+	if gs.Flags.NoColor || !gs.Stdout.IsTTY {
+		heading = fmt.Sprint
+		link = fmt.Sprint
+	}
+
+	_, _ = fmt.Fprintln(gs.Stdout, heading(ext.Module))
+
+	if ext.Description != "" {
+		_, _ = fmt.Fprintln(gs.Stdout, wordwrap.String(ext.Description, getTerminalWidth(gs)))
+	}
+
+	_, _ = fmt.Fprintln(gs.Stdout)
+
+	repo := none
+	if ext.Repo != nil && ext.Repo.URL != "" {
+		repo = link(ext.Repo.URL)
+	}
+
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	rows := [][2]string{
+		{"Name", name},
+		{"Tier", extensionTier(ext)},
+		{"Type", valueOrNone(extensionType(ext))},
+		{"Latest", valueOrNone(ext.Latest)},
+		{"Versions", listOrNone(sortVersions(ext.Versions))},
+		{"Imports", listOrNone(ext.Imports)},
+		{"Outputs", listOrNone(ext.Outputs)},
+		{"Subcommands", listOrNone(ext.Subcommands)},
+		{"Constraints", valueOrNone(ext.Constraints)},
+		{"Products", listOrNone(ext.Products)},
+		{"Categories", listOrNone(ext.Categories)},
+		{"Repository", repo},
+	}
+
+	if ext.Repo != nil && ext.Repo.Timestamp > 0 {
+		rows = append(rows, [2]string{"Updated", dates.dateTime(time.Unix(int64(ext.Repo.Timestamp), 0))})
+	}
+
+	if isDeprecated(ext) {
+		rows = append(rows, [2]string{"Status", "deprecated"})
+	}
+
+	if dep := ext.Deprecation; dep != nil {
+		for _, row := range [][2]string{
+			{"Deprecated since", dep.Since}, {"Removal planned", dep.Removal}, {"Replacement", dep.Replacement},
+		} {
+			if row[1] != "" {
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	if ext.Cgo {
+		rows = append(rows, [2]string{"Cgo", "required"})
+	}
+
+	if ext.Repo != nil && ext.Repo.Stars > 0 {
+		rows = append(rows, [2]string{"Stars", numbers.count(ext.Repo.Stars)})
+	}
+
+	if ext.Repo != nil && ext.Repo.License != "" {
+		rows = append(rows, [2]string{"License", ext.Repo.License})
+	}
+
+	if ext.Notes != "" {
+		rows = append(rows, [2]string{"Notes", ext.Notes})
+	}
+
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", row[0], row[1])
+	}
+
+	return w.Flush()
+}
+
+// sortVersions returns the versions in descending semver order.
+// Versions which are not valid semver are kept at the end in their original order.
+func sortVersions(versions []string) []string {
+	valid := make([]*semver.Version, 0, len(versions))
+	invalid := make([]string, 0)
+
+	for _, v := range versions {
+		ver, err := semver.NewVersion(v)
+		if err != nil {
+			invalid = append(invalid, v)
+
+			continue
+		}
+
+		valid = append(valid, ver)
+	}
+
+	sort.Sort(sort.Reverse(semver.Collection(valid)))
+
+	sorted := make([]string, 0, len(versions))
+
+	for _, ver := range valid {
+		sorted = append(sorted, ver.Original())
+	}
+
+	return append(sorted, invalid...)
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return none
+	}
+
+	return s
+}
+
+func listOrNone(list []string) string {
+	return valueOrNone(strings.Join(list, ", "))
+}
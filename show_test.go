@@ -0,0 +1,235 @@
+package explore
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestFindExtension(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker": {
+			Module:  "github.com/grafana/xk6-faker",
+			Imports: []string{"k6/x/faker"},
+		},
+		"xk6-dashboard": {
+			Module:      "github.com/grafana/xk6-dashboard",
+			Subcommands: []string{"dashboard"},
+		},
+	}
+
+	for _, query := range []string{"xk6-faker", "github.com/grafana/xk6-faker", "k6/x/faker"} {
+		name, ext := findExtension(catalog, query)
+		require.Equal(t, "xk6-faker", name, query)
+		require.NotNil(t, ext, query)
+	}
+
+	name, ext := findExtension(catalog, "k6/x/missing")
+	require.Empty(t, name)
+	require.Nil(t, ext)
+
+	// The first of the extensions declaring the same import path wins, whatever the map order.
+	catalog["xk6-faker-fork"] = &extension{Module: "github.com/example/xk6-faker", Imports: []string{"k6/x/faker"}}
+	catalog["xk6-a-faker"] = &extension{Module: "github.com/example/xk6-a-faker", Imports: []string{"k6/x/faker"}}
+
+	for range 10 {
+		name, _ = findExtension(catalog, "k6/x/faker")
+		require.Equal(t, "xk6-a-faker", name)
+	}
+}
+
+func TestSortVersions(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t,
+		[]string{"v1.0.0", "v0.5.0-beta.1", "v0.4.10", "v0.4.2", "invalid"},
+		sortVersions([]string{"v0.4.2", "invalid", "v1.0.0", "v0.4.10", "v0.5.0-beta.1"}),
+	)
+	require.Empty(t, sortVersions(nil))
+}
+
+func TestOutputShow(t *testing.T) {
+	t.Parallel()
+
+	ext := &extension{
+		Module:      "github.com/grafana/xk6-faker",
+		Tier:        "official",
+		Description: "Generate fake data in your tests",
+		Latest:      "v0.4.4",
+		Versions:    []string{"v0.4.3", "v0.4.4"},
+		Imports:     []string{"k6/x/faker"},
+		Constraints: ">=v0.50",
+		Repo:        &repository{URL: "https://github.com/grafana/xk6-faker"},
+		Notes:       "approved 2024-11",
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputShow(ts.GlobalState, "xk6-faker", ext, dateFormat{loc: time.UTC}, numberFormat{}))
+	require.Equal(t, `github.com/grafana/xk6-faker
+Generate fake data in your tests
+
+Name:         xk6-faker
+Tier:         Official
+Type:         JavaScript
+Latest:       v0.4.4
+Versions:     v0.4.4, v0.4.3
+Imports:      k6/x/faker
+Outputs:      -
+Subcommands:  -
+Constraints:  >=v0.50
+Products:     -
+Categories:   -
+Repository:   https://github.com/grafana/xk6-faker
+Notes:        approved 2024-11
+`, ts.Stdout.String())
+}
+
+func TestOutputShowUpdated(t *testing.T) {
+	t.Parallel()
+
+	updated := time.Date(2025, 3, 1, 23, 30, 0, 0, time.UTC)
+	ext := &extension{
+		Module: "github.com/grafana/xk6-faker",
+		Repo:   &repository{URL: "https://github.com/grafana/xk6-faker", Timestamp: float64(updated.Unix())},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputShow(ts.GlobalState, "xk6-faker", ext, dateFormat{loc: time.UTC}, numberFormat{}))
+	require.Contains(t, ts.Stdout.String(), "Updated:      2025-03-01T23:30:00Z\n")
+
+	ts = cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputShow(ts.GlobalState, "xk6-faker", ext, dateFormat{loc: time.FixedZone("CET", 3600)}, numberFormat{}))
+	require.Contains(t, ts.Stdout.String(), "Updated:      2025-03-02 00:30 CET\n")
+}
+
+func TestOutputShowDeprecation(t *testing.T) {
+	t.Parallel()
+
+	ext := &extension{
+		Module:      "github.com/grafana/xk6-browser",
+		Deprecation: &deprecation{Since: "2024-01-15", Replacement: "go.k6.io/k6/v2"},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputShow(ts.GlobalState, "xk6-browser", ext, dateFormat{loc: time.UTC}, numberFormat{}))
+
+	out := ts.Stdout.String()
+
+	require.Contains(t, out, "Status:            deprecated\n")
+	require.Contains(t, out, "Deprecated since:  2024-01-15\n")
+	require.Contains(t, out, "Replacement:       go.k6.io/k6/v2\n")
+	require.NotContains(t, out, "Removal planned")
+}
+
+func TestSelectShown(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-kafka":     {Module: "github.com/mostafa/xk6-kafka", Description: "Kafka producer and consumer"},
+		"xk6-amqp":      {Module: "github.com/grafana/xk6-amqp", Description: "AMQP, like Kafka"},
+		"xk6-sql":       {Module: "github.com/grafana/xk6-sql", Imports: []string{"k6/x/sql"}},
+		"xk6-sql-mysql": {Module: "github.com/grafana/xk6-sql-driver-mysql"},
+	}
+
+	names, err := selectShown(catalog, []string{"k6/x/sql", "xk6-sql-mysql"}, &matchPattern{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"xk6-sql", "xk6-sql-mysql"}, names)
+
+	var matching matchPattern
+
+	require.NoError(t, matching.Set("KAFKA"))
+
+	names, err = selectShown(catalog, nil, &matching)
+	require.NoError(t, err)
+	require.Equal(t, []string{"xk6-amqp", "xk6-kafka"}, names)
+
+	_, err = selectShown(catalog, []string{"xk6-sql", "xk6-missing"}, &matchPattern{})
+	require.ErrorIs(t, err, errExtensionNotFound)
+
+	require.NoError(t, matching.Set("redis"))
+
+	_, err = selectShown(catalog, nil, &matching)
+	require.ErrorIs(t, err, errExtensionNotFound)
+}
+
+func TestOutputShowAll(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-sql":   {Module: "github.com/grafana/xk6-sql"},
+		"xk6-kafka": {Module: "github.com/mostafa/xk6-kafka"},
+	}
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputShowAll(ts.GlobalState, catalog, []string{"xk6-sql", "xk6-kafka"},
+		dateFormat{loc: time.UTC}, numberFormat{}))
+
+	first, second, found := strings.Cut(ts.Stdout.String(), "\n"+strings.Repeat("-", showSeparatorWidth)+"\n\n")
+	require.True(t, found)
+	require.True(t, strings.HasPrefix(first, "github.com/grafana/xk6-sql\n"))
+	require.True(t, strings.HasPrefix(second, "github.com/mostafa/xk6-kafka\n"))
+}
+
+func TestShowCommandJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{name: "one name", args: []string{"xk6-sql"}, want: []string{"github.com/grafana/xk6-sql"}},
+		{
+			name: "several names",
+			args: []string{"xk6-sql", "xk6-kafka"},
+			want: []string{"github.com/grafana/xk6-sql", "github.com/mostafa/xk6-kafka"},
+		},
+		{name: "all matching", args: []string{"--all-matching", "kafka"}, want: []string{"github.com/mostafa/xk6-kafka"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := cmdtests.NewGlobalTestState(t)
+
+			_, err := exportSnapshot(ts.GlobalState, map[string]*extension{
+				"xk6-sql":   {Module: "github.com/grafana/xk6-sql", Description: "Use SQL databases"},
+				"xk6-kafka": {Module: "github.com/mostafa/xk6-kafka", Description: "Produce Kafka messages"},
+			}, "", "catalog.tar", time.Now())
+			require.NoError(t, err)
+
+			cmd := newShowCommand(&options{gs: ts.GlobalState, catalog: "bundle://catalog.tar"})
+			require.NoError(t, cmd.ParseFlags(append(tt.args, "--json")))
+			require.NoError(t, cmd.Args(cmd, cmd.Flags().Args()))
+			require.NoError(t, cmd.RunE(cmd, cmd.Flags().Args()))
+
+			// The shape does not depend on the number of extensions.
+			var got jsonMeta
+
+			require.NoError(t, json.Unmarshal(ts.Stdout.Bytes(), &got))
+			require.Equal(t, jsonSchemaVersion, got.SchemaVersion)
+			require.Equal(t, "bundle://catalog.tar", got.CatalogURL)
+			require.Equal(t, 2, got.TotalCount)
+			require.Equal(t, len(tt.want), got.FilteredCount)
+
+			modules := make([]string, 0, len(got.Extensions))
+			for _, ext := range got.Extensions {
+				modules = append(modules, ext.Module)
+			}
+
+			require.Equal(t, tt.want, modules)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package explore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+// runStatePath returns the file which records the results of the previous run of the
+// same command line, so unattended invocations with different filters don't interfere.
+func runStatePath(gs *state.GlobalState) string {
+	args := gs.CmdArgs
+	if len(args) > 0 {
+		args = args[1:]
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(args, "\x00")))
+
+	return filepath.Join(gs.UserOSConfigDir, "k6", "explore", "runs", hex.EncodeToString(sum[:8])+".json")
+}
+
+// unchangedSinceLastRun reports whether the results equal the results of the previous run
+// of the same command line, and records the current results for the next run.
+// The first run is always considered a change.
+func unchangedSinceLastRun(gs *state.GlobalState, extensions []*extension) (bool, error) {
+	current := make([]string, 0, len(extensions))
+	for _, ext := range extensions {
+		current = append(current, ext.Module+"@"+ext.Latest)
+	}
+
+	slices.Sort(current)
+
+	path := runStatePath(gs)
+
+	var previous []string
+
+	data, err := fsext.ReadFile(gs.FS, path)
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		previous = nil
+	case err != nil:
+		return false, err
+	default:
+		if err := json.Unmarshal(data, &previous); err != nil {
+			// A corrupt state file is treated like a missing one and gets overwritten.
+			previous = nil
+		}
+	}
+
+	if previous != nil && slices.Equal(previous, current) {
+		return true, nil
+	}
+
+	data, err = json.Marshal(current)
+	if err != nil {
+		return false, err
+	}
+
+	if err := gs.FS.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return false, err
+	}
+
+	return false, fsext.WriteFile(gs.FS, path, data, 0o600)
+}
@@ -0,0 +1,47 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestUnchangedSinceLastRun(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+	ts.CmdArgs = []string{"k6", "x", "explore", "--silent-success"}
+
+	faker := &extension{Module: "github.com/grafana/xk6-faker", Latest: "v0.4.4"}
+	tls := &extension{Module: "github.com/grafana/xk6-tls", Latest: "v0.1.0"}
+
+	unchanged, err := unchangedSinceLastRun(ts.GlobalState, []*extension{faker})
+	require.NoError(t, err)
+	require.False(t, unchanged, "first run")
+
+	unchanged, err = unchangedSinceLastRun(ts.GlobalState, []*extension{faker})
+	require.NoError(t, err)
+	require.True(t, unchanged, "same results")
+
+	unchanged, err = unchangedSinceLastRun(ts.GlobalState, []*extension{tls, faker})
+	require.NoError(t, err)
+	require.False(t, unchanged, "new result")
+
+	unchanged, err = unchangedSinceLastRun(ts.GlobalState, []*extension{faker, tls})
+	require.NoError(t, err)
+	require.True(t, unchanged, "order does not matter")
+
+	faker.Latest = "v0.5.0"
+
+	unchanged, err = unchangedSinceLastRun(ts.GlobalState, []*extension{faker, tls})
+	require.NoError(t, err)
+	require.False(t, unchanged, "new version")
+
+	// Other command lines have their own state.
+	ts.CmdArgs = []string{"k6", "x", "explore", "--silent-success", "--tier", "official"}
+
+	unchanged, err = unchangedSinceLastRun(ts.GlobalState, []*extension{faker, tls})
+	require.NoError(t, err)
+	require.False(t, unchanged, "other command line")
+}
@@ -0,0 +1,269 @@
+package explore
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+var (
+	errInvalidCatalogSource = errors.New("invalid catalog source: supported sources are bundle://<file>")
+	errInvalidSnapshot      = errors.New("invalid catalog snapshot")
+	errSnapshotDigest       = errors.New("digest verification failed")
+)
+
+const (
+	// snapshotScheme prefixes the path of a snapshot given to the --catalog flag.
+	snapshotScheme = "bundle://"
+
+	snapshotCatalogFile  = "catalog.json"
+	snapshotMetadataFile = "metadata.json"
+	snapshotDigestsFile  = "SHA256SUMS"
+
+	// snapshotDigestLen is the length of the catalog digest prefix in default file names.
+	snapshotDigestLen = 12
+
+	exportHelpShort = "Export a catalog snapshot"
+	exportHelpLong  = `Export the extension catalog into a single tar file.
+
+The snapshot contains the catalog, its metadata (creation time, source and
+catalog digest) and the SHA-256 digests of both, so it can be verified after
+being carried into an air-gapped environment, where it is consumed with the
+--catalog bundle://<file> flag.
+
+Without a file name, the snapshot is written to k6-catalog-<digest>.tar in the
+current directory, named after the SHA-256 digest of the catalog.
+`
+	exportHelpExample = `
+# Export a snapshot of the catalog:
+k6 x explore export --bundle catalog.tar
+
+# List the extensions of the snapshot in an air-gapped environment:
+k6 x explore --catalog bundle://catalog.tar
+`
+)
+
+// snapshotMetadata describes a catalog snapshot.
+type snapshotMetadata struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	Source     string    `json:"source"`
+	Extensions int       `json:"extensions"`
+	// Digest is the SHA-256 digest of the catalog file.
+	Digest string `json:"digest"`
+}
+
+// newExportCommand creates the "export" subcommand of explore.
+func newExportCommand(opts *options) *cobra.Command {
+	var path string
+
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   exportHelpShort,
+		Long:    exportHelpLong,
+		Example: exportHelpExample,
+		Args:    cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			catalog, err := fetchCatalog(*opts)
+			if err != nil {
+				return err
+			}
+
+			source := opts.catalog
+			if source == "" {
+				source = catalogURL(*opts)
+			}
+
+			written, err := exportSnapshot(opts.gs, catalog, source, path, time.Now())
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintln(opts.gs.Stdout, written)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&path, "bundle", "", "the tar file to write (default k6-catalog-<digest>.tar)")
+
+	return cmd
+}
+
+// exportSnapshot writes the catalog snapshot to path, or to a file named after the
+// catalog digest if path is empty, and returns the path of the written file.
+func exportSnapshot(
+	gs *state.GlobalState,
+	catalog map[string]*extension,
+	source string,
+	path string,
+	now time.Time,
+) (string, error) {
+	catalogData, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256Hex(catalogData)
+
+	metadataData, err := json.MarshalIndent(&snapshotMetadata{
+		CreatedAt:  now.UTC(),
+		Source:     source,
+		Extensions: len(catalog),
+		Digest:     digest,
+	}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	digests := fmt.Sprintf("%s  %s\n%s  %s\n",
+		digest, snapshotCatalogFile, sha256Hex(metadataData), snapshotMetadataFile)
+
+	var buf bytes.Buffer
+
+	w := tar.NewWriter(&buf)
+
+	for _, file := range []struct {
+		name string
+		data []byte
+	}{
+		{snapshotCatalogFile, catalogData},
+		{snapshotMetadataFile, metadataData},
+		{snapshotDigestsFile, []byte(digests)},
+	} {
+		hdr := &tar.Header{Name: file.name, Mode: 0o644, Size: int64(len(file.data)), ModTime: now}
+
+		if err := w.WriteHeader(hdr); err != nil {
+			return "", err
+		}
+
+		if _, err := w.Write(file.data); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	if path == "" {
+		path = "k6-catalog-" + digest[:snapshotDigestLen] + ".tar"
+	}
+
+	return path, fsext.WriteFile(gs.FS, path, buf.Bytes(), 0o644)
+}
+
+// importSnapshot reads the catalog of a snapshot after verifying its digests.
+func importSnapshot(gs *state.GlobalState, path string) (map[string]*extension, error) {
+	catalog, _, err := readSnapshot(gs, path)
+
+	return catalog, err
+}
+
+// readSnapshot reads the catalog and the metadata of a snapshot after verifying its digests.
+func readSnapshot(gs *state.GlobalState, path string) (map[string]*extension, *snapshotMetadata, error) {
+	data, err := fsext.ReadFile(gs.FS, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := make(map[string][]byte)
+
+	r := tar.NewReader(bytes.NewReader(data))
+
+	for {
+		hdr, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w %s: %w", errInvalidSnapshot, path, err)
+		}
+
+		content, err := io.ReadAll(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w %s: %w", errInvalidSnapshot, path, err)
+		}
+
+		files[hdr.Name] = content
+	}
+
+	if err := verifyDigests(files); err != nil {
+		return nil, nil, fmt.Errorf("%w %s: %w", errInvalidSnapshot, path, err)
+	}
+
+	catalog, err := decodeCatalogV1(bytes.NewReader(files[snapshotCatalogFile]), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w %s: %w", errInvalidSnapshot, path, err)
+	}
+
+	for _, ext := range catalog {
+		ext.Latest = findLatest(ext.Versions, false)
+	}
+
+	metadata := new(snapshotMetadata)
+
+	if err := json.Unmarshal(files[snapshotMetadataFile], metadata); err != nil {
+		return nil, nil, fmt.Errorf("%w %s: %w", errInvalidSnapshot, path, err)
+	}
+
+	return catalog, metadata, nil
+}
+
+// verifyDigests checks the files of a snapshot against its SHA256SUMS file.
+// The catalog and the metadata files must be present and listed.
+func verifyDigests(files map[string][]byte) error {
+	sums, found := files[snapshotDigestsFile]
+	if !found {
+		return fmt.Errorf("%w: missing %s", errSnapshotDigest, snapshotDigestsFile)
+	}
+
+	verified := make([]string, 0, len(files))
+
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+
+	for scanner.Scan() {
+		digest, name, found := strings.Cut(scanner.Text(), "  ")
+		if !found {
+			continue
+		}
+
+		content, exists := files[name]
+		if !exists {
+			return fmt.Errorf("%w: missing %s", errSnapshotDigest, name)
+		}
+
+		if sha256Hex(content) != digest {
+			return fmt.Errorf("%w: %s was modified", errSnapshotDigest, name)
+		}
+
+		verified = append(verified, name)
+	}
+
+	for _, name := range []string{snapshotCatalogFile, snapshotMetadataFile} {
+		if !slices.Contains(verified, name) {
+			return fmt.Errorf("%w: no digest of %s", errSnapshotDigest, name)
+		}
+	}
+
+	return scanner.Err()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
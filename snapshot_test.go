@@ -0,0 +1,119 @@
+package explore
+
+import (
+	"archive/tar"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	catalog := map[string]*extension{
+		"xk6-faker": {
+			Module:   "github.com/grafana/xk6-faker",
+			Tier:     "official",
+			Versions: []string{"v0.4.3", "v0.4.4"},
+			Imports:  []string{"k6/x/faker"},
+		},
+	}
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	path, err := exportSnapshot(ts.GlobalState, catalog, "https://registry.k6.io/v2/catalog.json", "", now)
+	require.NoError(t, err)
+	require.Regexp(t, `^k6-catalog-[0-9a-f]{12}\.tar$`, path)
+
+	imported, err := importSnapshot(ts.GlobalState, path)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, "github.com/grafana/xk6-faker", imported["xk6-faker"].Module)
+	require.Equal(t, "v0.4.4", imported["xk6-faker"].Latest)
+
+	// The same catalog is exported to the same file name.
+	again, err := exportSnapshot(ts.GlobalState, catalog, "elsewhere", "", now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, path, again)
+
+	named, err := exportSnapshot(ts.GlobalState, catalog, "", "catalog.tar", now)
+	require.NoError(t, err)
+	require.Equal(t, "catalog.tar", named)
+}
+
+func TestImportSnapshotTampered(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	write := func(t *testing.T, path string, files map[string]string) {
+		t.Helper()
+
+		var buf bytes.Buffer
+
+		w := tar.NewWriter(&buf)
+
+		for name, content := range files {
+			require.NoError(t, w.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+			_, err := w.Write([]byte(content))
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, w.Close())
+		require.NoError(t, fsext.WriteFile(ts.FS, path, buf.Bytes(), 0o644))
+	}
+
+	catalog := `{"xk6-faker":{"module":"github.com/grafana/xk6-faker"}}`
+	metadata := `{}`
+	sums := sha256Hex([]byte(catalog)) + "  catalog.json\n" + sha256Hex([]byte(metadata)) + "  metadata.json\n"
+
+	write(t, "valid.tar", map[string]string{"catalog.json": catalog, "metadata.json": metadata, "SHA256SUMS": sums})
+	write(t, "modified.tar", map[string]string{
+		"catalog.json": strings.Replace(catalog, "faker", "fakes", 1), "metadata.json": metadata, "SHA256SUMS": sums,
+	})
+	write(t, "unsigned.tar", map[string]string{"catalog.json": catalog, "metadata.json": metadata})
+	write(t, "partial.tar", map[string]string{
+		"catalog.json": catalog, "metadata.json": metadata, "SHA256SUMS": strings.SplitAfter(sums, "\n")[0],
+	})
+
+	_, err := importSnapshot(ts.GlobalState, "valid.tar")
+	require.NoError(t, err)
+
+	for _, path := range []string{"modified.tar", "unsigned.tar", "partial.tar"} {
+		_, err := importSnapshot(ts.GlobalState, path)
+		require.ErrorIs(t, err, errInvalidSnapshot, path)
+		require.ErrorIs(t, err, errSnapshotDigest, path)
+	}
+
+	require.NoError(t, fsext.WriteFile(ts.FS, "garbage.tar", []byte("not a tar file"), 0o644))
+
+	_, err = importSnapshot(ts.GlobalState, "garbage.tar")
+	require.ErrorIs(t, err, errInvalidSnapshot)
+}
+
+func TestFetchCatalogSource(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	catalog := map[string]*extension{"xk6-faker": {Module: "github.com/grafana/xk6-faker"}}
+
+	_, err := exportSnapshot(ts.GlobalState, catalog, "", "catalog.tar", time.Now())
+	require.NoError(t, err)
+
+	fetched, err := fetchCatalog(options{gs: ts.GlobalState, catalog: "bundle://catalog.tar"})
+	require.NoError(t, err)
+	require.Contains(t, fetched, "xk6-faker")
+
+	for _, source := range []string{"bundle://", "catalog.tar", "https://registry.example.com/catalog.json"} {
+		_, err := fetchCatalog(options{gs: ts.GlobalState, catalog: source})
+		require.ErrorIs(t, err, errInvalidCatalogSource, source)
+	}
+}
@@ -0,0 +1,156 @@
+package explore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+const (
+	starHelpShort = "Star extensions"
+	starHelpLong  = `Add extensions to the starred shortlist, or remove them with --remove.
+
+The shortlist is kept in the k6 user configuration directory, so extensions
+can be evaluated over several days. Use the --starred flag of the explore
+command to list the starred extensions only.
+
+Extensions can be referenced by catalog name, module path or import path.
+`
+	starHelpExample = `
+# Star two extensions:
+k6 x explore star xk6-faker xk6-sql
+
+# List the starred extensions:
+k6 x explore --starred
+
+# Remove an extension from the shortlist:
+k6 x explore star --remove xk6-sql
+`
+)
+
+// newStarCommand creates the "star" subcommand of explore.
+func newStarCommand(opts *options) *cobra.Command {
+	var remove bool
+
+	cmd := &cobra.Command{
+		Use:     "star <name>...",
+		Short:   starHelpShort,
+		Long:    starHelpLong,
+		Example: starHelpExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cfg, err := loadConfig(opts.gs)
+			if err != nil {
+				return err
+			}
+
+			catalog, err := loadCatalog(*opts, cfg)
+			if err != nil {
+				return err
+			}
+
+			modules := make([]string, 0, len(args))
+
+			for _, query := range args {
+				_, ext := findExtension(catalog, query)
+				if ext == nil {
+					return fmt.Errorf("%w: %s", errExtensionNotFound, query)
+				}
+
+				modules = append(modules, ext.Module)
+			}
+
+			return updateStarred(opts.gs, modules, remove)
+		},
+	}
+
+	cmd.Flags().BoolVar(&remove, "remove", false, "remove the extensions from the starred shortlist")
+
+	return cmd
+}
+
+// starredPath returns the file which holds the module paths of the starred extensions.
+func starredPath(gs *state.GlobalState) string {
+	return filepath.Join(gs.UserOSConfigDir, "k6", "explore", "starred.json")
+}
+
+// loadStarred returns the module paths of the starred extensions.
+// A missing file is not an error, no extension is starred then.
+func loadStarred(gs *state.GlobalState) ([]string, error) {
+	path := starredPath(gs)
+
+	data, err := fsext.ReadFile(gs.FS, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var starred []string
+
+	if err := json.Unmarshal(data, &starred); err != nil {
+		return nil, fmt.Errorf("invalid starred extensions file %s: %w", path, err)
+	}
+
+	return starred, nil
+}
+
+// updateStarred adds the modules to the starred extensions, or removes them.
+func updateStarred(gs *state.GlobalState, modules []string, remove bool) error {
+	starred, err := loadStarred(gs)
+	if err != nil {
+		return err
+	}
+
+	for _, module := range modules {
+		if remove {
+			starred = slices.DeleteFunc(starred, func(s string) bool { return s == module })
+		} else if !slices.Contains(starred, module) {
+			starred = append(starred, module)
+		}
+	}
+
+	slices.Sort(starred)
+
+	data, err := json.MarshalIndent(starred, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := starredPath(gs)
+
+	if err := gs.FS.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	return fsext.WriteFile(gs.FS, path, data, 0o600)
+}
+
+// starredCatalog returns the starred extensions of the catalog. The module paths are
+// compared after normalization, see normalizeModule, as the list can be edited by hand.
+func starredCatalog(catalog map[string]*extension, starred []string) map[string]*extension {
+	modules := make(map[string]bool, len(starred))
+
+	for _, module := range starred {
+		modules[normalizeModule(module)] = true
+	}
+
+	found := make(map[string]*extension)
+
+	for name, ext := range catalog {
+		if modules[normalizeModule(ext.Module)] {
+			found[name] = ext
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,57 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+	"go.k6.io/k6/v2/lib/fsext"
+)
+
+func TestUpdateStarred(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	starred, err := loadStarred(ts.GlobalState)
+	require.NoError(t, err)
+	require.Empty(t, starred)
+
+	require.NoError(t, updateStarred(ts.GlobalState,
+		[]string{"github.com/grafana/xk6-sql", "github.com/grafana/xk6-faker"}, false))
+	require.NoError(t, updateStarred(ts.GlobalState, []string{"github.com/grafana/xk6-faker"}, false))
+
+	starred, err = loadStarred(ts.GlobalState)
+	require.NoError(t, err)
+	require.Equal(t, []string{"github.com/grafana/xk6-faker", "github.com/grafana/xk6-sql"}, starred)
+
+	require.NoError(t, updateStarred(ts.GlobalState, []string{"github.com/grafana/xk6-sql"}, true))
+
+	starred, err = loadStarred(ts.GlobalState)
+	require.NoError(t, err)
+	require.Equal(t, []string{"github.com/grafana/xk6-faker"}, starred)
+
+	require.NoError(t, fsext.WriteFile(ts.FS, starredPath(ts.GlobalState), []byte("{"), 0o600))
+
+	_, err = loadStarred(ts.GlobalState)
+	require.Error(t, err)
+}
+
+func TestStarredCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog := map[string]*extension{
+		"xk6-faker": {Module: "github.com/grafana/xk6-faker"},
+		"xk6-sql":   {Module: "github.com/grafana/xk6-sql"},
+	}
+
+	require.Equal(t,
+		map[string]*extension{"xk6-faker": catalog["xk6-faker"]},
+		starredCatalog(catalog, []string{"github.com/grafana/xk6-faker", "github.com/grafana/xk6-gone"}),
+	)
+	require.Equal(t,
+		map[string]*extension{"xk6-sql": catalog["xk6-sql"]},
+		starredCatalog(catalog, []string{"https://GitHub.com/grafana/xk6-sql.git"}),
+	)
+	require.Empty(t, starredCatalog(catalog, nil))
+}
@@ -0,0 +1,98 @@
+package explore
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+// Cache statuses of the --reuse-fetch memo in the run summary.
+const (
+	cacheHit  = "hit"
+	cacheMiss = "miss"
+)
+
+// runSummary collects what a run did, printed to the standard error with -v so that
+// CI logs explain where the listed extensions came from, and reported by --json-meta.
+type runSummary struct {
+	started  time.Time
+	verbose  bool
+	source   string
+	url      string
+	cache    string
+	fetched  int
+	total    int
+	warnings warningCounter
+}
+
+// warningCounter is a logger hook counting the logged warnings.
+type warningCounter struct {
+	count atomic.Int64
+}
+
+func (c *warningCounter) Levels() []logrus.Level {
+	return []logrus.Level{logrus.WarnLevel}
+}
+
+func (c *warningCounter) Fire(*logrus.Entry) error {
+	c.count.Add(1)
+
+	return nil
+}
+
+// newRunSummary starts the summary of a run, printed in verbose mode. Otherwise it
+// returns nil, unless the summary is needed anyway, e.g. by --json-meta.
+func newRunSummary(gs *state.GlobalState, needed bool) *runSummary {
+	if !gs.Flags.Verbose && !needed {
+		return nil
+	}
+
+	summary := &runSummary{started: time.Now(), verbose: gs.Flags.Verbose}
+
+	if summary.verbose {
+		gs.Logger.AddHook(&summary.warnings)
+	}
+
+	return summary
+}
+
+// fetchedFrom records the catalog source, its URL and its number of entries.
+func (s *runSummary) fetchedFrom(source string, url string, cache string, entries int) {
+	if s == nil {
+		return
+	}
+
+	s.source, s.url, s.cache, s.fetched = source, url, cache, entries
+}
+
+// loaded records the number of extensions of the catalog, before filtering.
+func (s *runSummary) loaded(total int) {
+	if s == nil {
+		return
+	}
+
+	s.total = total
+}
+
+// write prints the summary as a single line, e.g. "Summary: source registry (cache miss),
+// 120 entries fetched, 12 listed, 1.2s, 0 warnings".
+func (s *runSummary) write(gs *state.GlobalState, listed int) {
+	if s == nil || !s.verbose {
+		return
+	}
+
+	source := s.source
+	if source == "" {
+		source = "none"
+	}
+
+	if s.cache != "" {
+		source += " (cache " + s.cache + ")"
+	}
+
+	_, _ = fmt.Fprintf(gs.Stderr, "Summary: source %s, %d entries fetched, %d listed, %s, %d warnings\n",
+		source, s.fetched, listed, time.Since(s.started).Round(time.Millisecond), s.warnings.count.Load())
+}
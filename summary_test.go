@@ -0,0 +1,57 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestRunSummary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("verbose", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+		ts.Flags.Verbose = true
+
+		summary := newRunSummary(ts.GlobalState, false)
+		summary.fetchedFrom(sourceRegistry, "https://registry.k6.io/catalog.json", cacheHit, 120)
+
+		ts.Logger.Warn("Skipping invalid catalog entry")
+		ts.Logger.Info("Fetched catalog page 1")
+
+		summary.write(ts.GlobalState, 12)
+
+		require.Regexp(t,
+			`^Summary: source registry \(cache hit\), 120 entries fetched, 12 listed, [0-9.]+m?s, 1 warnings\n$`,
+			ts.Stderr.String())
+	})
+
+	t.Run("not verbose", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+
+		summary := newRunSummary(ts.GlobalState, false)
+		summary.fetchedFrom(sourceRegistry, "", "", 120)
+		summary.write(ts.GlobalState, 12)
+
+		require.Nil(t, summary)
+		require.Empty(t, ts.Stderr.String())
+	})
+	t.Run("needed", func(t *testing.T) {
+		t.Parallel()
+
+		ts := cmdtests.NewGlobalTestState(t)
+
+		summary := newRunSummary(ts.GlobalState, true)
+		summary.fetchedFrom(sourceSnapshot, "bundle://catalog.json", "", 120)
+		summary.write(ts.GlobalState, 12)
+
+		require.NotNil(t, summary)
+		require.Equal(t, "bundle://catalog.json", summary.url)
+		require.Empty(t, ts.Stderr.String(), "printed only in verbose mode")
+	})
+}
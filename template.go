@@ -0,0 +1,57 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+var errInvalidTemplate = errors.New("invalid go-template format")
+
+// templatePrefix prefixes the template of the go-template format, e.g. go-template={{.Module}}.
+const templatePrefix = string(formatTemplate) + "="
+
+// templateFuncs are the functions available in the templates of the go-template format,
+// in addition to the text/template builtins.
+//
+//nolint:gochecknoglobals
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// parseTemplate parses the template of the go-template format.
+func parseTemplate(spec string) (*template.Template, error) {
+	tmpl, err := template.New(string(formatTemplate)).Funcs(templateFuncs).Option("missingkey=error").Parse(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTemplate, err)
+	}
+
+	return tmpl, nil
+}
+
+// outputTemplate renders the template for each extension, followed by a line break.
+// The template is executed on the JSON representation of the extension, with the Go
+// field names, e.g. {{.Module}}@{{.Latest}} or {{join .Imports ","}}.
+func outputTemplate(gs *state.GlobalState, spec string, list []*extensionJSON) error {
+	tmpl, err := parseTemplate(spec)
+	if err != nil {
+		return err
+	}
+
+	for _, ext := range list {
+		var buf strings.Builder
+
+		if err := tmpl.Execute(&buf, ext); err != nil {
+			return fmt.Errorf("%w: %s: %w", errInvalidTemplate, ext.Module, err)
+		}
+
+		_, _ = fmt.Fprintln(gs.Stdout, buf.String())
+	}
+
+	return nil
+}
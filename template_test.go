@@ -0,0 +1,53 @@
+package explore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestOutputTemplate(t *testing.T) {
+	t.Parallel()
+
+	extensions := []*extension{
+		{Module: "github.com/grafana/xk6-faker", Tier: "Official", Latest: "v0.4.4", Imports: []string{"k6/x/faker"}, Outputs: []string{"fake"}},
+		{Module: "github.com/example/xk6-output-foo", Latest: "v1.0.0", Outputs: []string{"foo"}},
+	}
+
+	var f format
+
+	require.NoError(t, f.Set(`go-template={{.Module}}@{{.Latest}} {{upper .Tier}} {{join .Kinds ","}}`))
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, output(options{gs: ts.GlobalState, format: f}, extensions, time.Now()))
+	require.Equal(t, "github.com/grafana/xk6-faker@v0.4.4 OFFICIAL javascript,output\n"+
+		"github.com/example/xk6-output-foo@v1.0.0 COMMUNITY output\n", ts.Stdout.String())
+
+	require.NoError(t, f.Set("go-template={{.Repo.Stars}}"))
+
+	ts = cmdtests.NewGlobalTestState(t)
+
+	err := output(options{gs: ts.GlobalState, format: f}, extensions, time.Now())
+	require.ErrorIs(t, err, errInvalidTemplate)
+	require.ErrorContains(t, err, "github.com/grafana/xk6-faker")
+}
+
+func TestParseTemplate(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseTemplate("{{.Module}}")
+	require.NoError(t, err)
+
+	for _, invalid := range []string{"{{.Module", "{{nope .Module}}"} {
+		_, err := parseTemplate(invalid)
+		require.ErrorIs(t, err, errInvalidTemplate, invalid)
+	}
+
+	var f format
+
+	require.ErrorIs(t, f.Set("go-template={{.Module"), errInvalidTemplate)
+	require.ErrorIs(t, f.Set("go-template"), errInvalidFormat)
+}
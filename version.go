@@ -53,6 +53,30 @@ func detectK6Major(env map[string]string, readBuildInfo func() (*debug.BuildInfo
 	return defaultK6Major
 }
 
+// detectK6Version returns the version of the running k6, e.g. v2.0.1, with the same
+// precedence as detectK6Major, or an empty string if it is not known.
+func detectK6Version(env map[string]string, readBuildInfo func() (*debug.BuildInfo, bool)) string {
+	if version := env["K6_PROVISION_HOST_VERSION"]; parseMajor(version) > 0 {
+		return version
+	}
+
+	info, ok := readBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	// k6 itself is the main module when this extension is built into a k6 checkout.
+	modules := append([]*debug.Module{&info.Main}, info.Deps...)
+
+	for _, mod := range modules {
+		if k6ModuleRe.MatchString(mod.Path) && mod.Version != "" && mod.Version != "(devel)" {
+			return mod.Version
+		}
+	}
+
+	return ""
+}
+
 // catalogURLForVersion returns the registry catalog URL for the given k6 major.
 func catalogURLForVersion(major int) string {
 	return fmt.Sprintf("%s/v%d/catalog.json", defaultRegistryHost, major)
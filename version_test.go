@@ -132,3 +132,51 @@ func Test_catalogURLForVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_detectK6Version(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		info *debug.BuildInfo
+		want string
+	}{
+		{
+			name: "from env",
+			env:  map[string]string{"K6_PROVISION_HOST_VERSION": "v2.1.0"},
+			info: &debug.BuildInfo{Deps: []*debug.Module{{Path: "go.k6.io/k6/v2", Version: "v2.0.0"}}},
+			want: "v2.1.0",
+		},
+		{
+			name: "from dependency",
+			info: &debug.BuildInfo{Deps: []*debug.Module{{Path: "go.k6.io/k6/v2", Version: "v2.0.0"}}},
+			want: "v2.0.0",
+		},
+		{
+			name: "from main module",
+			info: &debug.BuildInfo{Main: debug.Module{Path: "go.k6.io/k6/v2", Version: "v2.0.1"}},
+			want: "v2.0.1",
+		},
+		{
+			name: "development build",
+			info: &debug.BuildInfo{Main: debug.Module{Path: "go.k6.io/k6/v2", Version: "(devel)"}},
+			want: "",
+		},
+		{
+			name: "no build info",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := detectK6Version(tt.env, func() (*debug.BuildInfo, bool) {
+				return tt.info, tt.info != nil
+			})
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
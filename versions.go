@@ -0,0 +1,224 @@
+package explore
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+	"go.k6.io/k6/v2/cmd/state"
+)
+
+var errInvalidConstraint = errors.New("invalid version constraint")
+
+const (
+	versionsHelpShort = "List the versions of an extension"
+	versionsHelpLong  = `List all versions of a single extension, newest first.
+
+The latest version and the latest stable (non-prerelease) version are marked,
+which helps pinning versions in "k6 with" pragmas of scripts.
+
+The --constraint flag keeps only the versions matching a semver constraint,
+e.g. ">=0.4, <1.0" or "~0.4". Prereleases only match constraints which
+contain a prerelease themselves.
+
+The extension can be referenced by catalog name, module path or import path.
+
+The --resolve-all-versions flag reconciles the versions of every catalog entry
+with the Go module proxy (GOPROXY) instead, and reports a freshness matrix:
+the latest version of the catalog and of the proxy, the catalog versions unknown
+to the proxy and the proxy versions missing from the catalog. The proxy is queried
+concurrently, bounded by --workers and --rate to stay below its rate limits.
+`
+	versionsHelpExample = `
+# List the versions of an extension:
+k6 x explore versions xk6-faker
+
+# List the v0.4.x versions only:
+k6 x explore versions xk6-faker --constraint "~0.4"
+
+# Output as JSON:
+k6 x explore versions xk6-faker --json
+
+# Check the whole catalog against the module proxy, at most 5 requests per second:
+k6 x explore versions --resolve-all-versions --rate 5
+`
+
+	versionsHeader = "VERSION\tMARK\n"
+
+	markLatest       = "latest"
+	markLatestStable = "latest stable"
+)
+
+// versionInfo is a single version of an extension as listed by the versions subcommand.
+type versionInfo struct {
+	Version      string `json:"version"`
+	Latest       bool   `json:"latest,omitempty"`
+	LatestStable bool   `json:"latestStable,omitempty"`
+}
+
+// newVersionsCommand creates the "versions" subcommand of explore.
+func newVersionsCommand(opts *options) *cobra.Command {
+	var (
+		asJSON     bool
+		constraint string
+		resolveAll bool
+		workers    int
+		rate       float64
+	)
+
+	cmd := &cobra.Command{
+		Use:     "versions <name>",
+		Short:   versionsHelpShort,
+		Long:    versionsHelpLong,
+		Example: versionsHelpExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if resolveAll {
+				return cobra.NoArgs(cmd, args)
+			}
+
+			return cobra.ExactArgs(1)(cmd, args)
+		},
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return validateResolveLimits(workers, rate)
+		},
+		RunE: func(_ *cobra.Command, args []string) error {
+			if resolveAll {
+				return runResolveAll(*opts, asJSON, workers, rate)
+			}
+
+			var constraints *semver.Constraints
+
+			if constraint != "" {
+				var err error
+
+				constraints, err = semver.NewConstraint(constraint)
+				if err != nil {
+					return fmt.Errorf("%w: %w", errInvalidConstraint, err)
+				}
+			}
+
+			_, ext, err := loadExtension(*opts, args[0])
+			if err != nil {
+				return err
+			}
+
+			versions := listVersions(ext, constraints)
+
+			if asJSON {
+				return outputJSON(opts.gs, versions)
+			}
+
+			return outputVersions(opts.gs, versions)
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.BoolVar(&asJSON, "json", false, "output in JSON format")
+	flags.StringVar(&constraint, "constraint", "", "only list versions matching a semver constraint (e.g. \"~0.4\")")
+	flags.BoolVar(&resolveAll, "resolve-all-versions", false,
+		"reconcile the versions of every catalog entry with the Go module proxy")
+	flags.IntVar(&workers, "workers", defaultResolveWorkers, "number of concurrent module proxy requests")
+	flags.Float64Var(&rate, "rate", 0, "maximum module proxy requests per second, 0 means unlimited")
+
+	return cmd
+}
+
+// listVersions returns the versions of the extension in descending semver order,
+// marking the latest and the latest stable version. When constraints is not nil,
+// only the matching versions are returned; versions which are not valid semver never match.
+func listVersions(ext *extension, constraints *semver.Constraints) []*versionInfo {
+	latestStable := findLatestStable(ext.Versions)
+	versions := make([]*versionInfo, 0, len(ext.Versions))
+
+	for _, v := range sortVersions(ext.Versions) {
+		if constraints != nil {
+			ver, err := semver.NewVersion(v)
+			if err != nil || !constraints.Check(ver) {
+				continue
+			}
+		}
+
+		versions = append(versions, &versionInfo{
+			Version:      v,
+			Latest:       v == ext.Latest,
+			LatestStable: v == latestStable,
+		})
+	}
+
+	return versions
+}
+
+// findLatestStable returns the highest version which is not a prerelease,
+// or an empty string if there is none.
+func findLatestStable(versions []string) string {
+	var latest *semver.Version
+
+	for _, v := range versions {
+		ver, err := semver.NewVersion(v)
+		if err != nil || ver.Prerelease() != "" {
+			continue
+		}
+
+		if latest == nil || ver.GreaterThan(latest) {
+			latest = ver
+		}
+	}
+
+	if latest == nil {
+		return ""
+	}
+
+	return latest.Original()
+}
+
+// findLatestPrerelease returns the highest prerelease version newer than the latest
+// stable version, or an empty string if there is none.
+func findLatestPrerelease(versions []string) string {
+	var latest *semver.Version
+
+	if stable := findLatestStable(versions); stable != "" {
+		latest = semver.MustParse(stable)
+	}
+
+	prerelease := ""
+
+	for _, v := range versions {
+		ver, err := semver.NewVersion(v)
+		if err != nil || ver.Prerelease() == "" {
+			continue
+		}
+
+		if latest == nil || ver.GreaterThan(latest) {
+			latest = ver
+			prerelease = v
+		}
+	}
+
+	return prerelease
+}
+
+func outputVersions(gs *state.GlobalState, versions []*versionInfo) error {
+	w := tabwriter.NewWriter(gs.Stdout, 0, 0, columnPadding, ' ', 0)
+
+	_, _ = w.Write([]byte(versionsHeader))
+
+	for _, v := range versions {
+		marks := make([]string, 0, 2) //nolint:mnd
+
+		if v.Latest {
+			marks = append(marks, markLatest)
+		}
+
+		if v.LatestStable {
+			marks = append(marks, markLatestStable)
+		}
+
+		_, _ = w.Write([]byte(v.Version + "\t" + strings.Join(marks, ", ") + "\n"))
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,96 @@
+package explore
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/require"
+	cmdtests "go.k6.io/k6/v2/cmd/tests"
+)
+
+func TestFindLatestStable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		versions []string
+		want     string
+	}{
+		{name: "empty", versions: nil, want: ""},
+		{name: "stable", versions: []string{"v0.4.2", "v0.4.10", "v0.3.0"}, want: "v0.4.10"},
+		{name: "prerelease skipped", versions: []string{"v0.4.2", "v0.5.0-rc.1"}, want: "v0.4.2"},
+		{name: "only prereleases", versions: []string{"v0.5.0-rc.1", "v0.5.0-rc.2"}, want: ""},
+		{name: "invalid skipped", versions: []string{"invalid", "v0.1.0"}, want: "v0.1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, findLatestStable(tt.versions))
+		})
+	}
+}
+
+func TestFindLatestPrerelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		versions []string
+		want     string
+	}{
+		{name: "empty", versions: nil, want: ""},
+		{name: "newer prerelease", versions: []string{"v0.4.2", "v0.5.0-rc.1", "v0.5.0-rc.2"}, want: "v0.5.0-rc.2"},
+		{name: "older prerelease", versions: []string{"v0.4.0-rc.1", "v0.4.2"}, want: ""},
+		{name: "only prereleases", versions: []string{"v0.5.0-rc.2", "v0.5.0-rc.1"}, want: "v0.5.0-rc.2"},
+		{name: "invalid skipped", versions: []string{"invalid", "v0.1.0-beta"}, want: "v0.1.0-beta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, findLatestPrerelease(tt.versions))
+		})
+	}
+}
+
+func TestListVersions(t *testing.T) {
+	t.Parallel()
+
+	ext := &extension{
+		Latest:   "v0.5.0-rc.1",
+		Versions: []string{"v0.4.2", "v0.5.0-rc.1", "v0.3.0", "v0.4.10"},
+	}
+
+	require.Equal(t, []*versionInfo{
+		{Version: "v0.5.0-rc.1", Latest: true},
+		{Version: "v0.4.10", LatestStable: true},
+		{Version: "v0.4.2"},
+		{Version: "v0.3.0"},
+	}, listVersions(ext, nil))
+
+	constraints, err := semver.NewConstraint("~0.4")
+	require.NoError(t, err)
+
+	require.Equal(t, []*versionInfo{
+		{Version: "v0.4.10", LatestStable: true},
+		{Version: "v0.4.2"},
+	}, listVersions(ext, constraints))
+}
+
+func TestOutputVersions(t *testing.T) {
+	t.Parallel()
+
+	ts := cmdtests.NewGlobalTestState(t)
+
+	require.NoError(t, outputVersions(ts.GlobalState, []*versionInfo{
+		{Version: "v0.5.0", Latest: true, LatestStable: true},
+		{Version: "v0.4.2"},
+	}))
+	require.Equal(t, `VERSION  MARK
+v0.5.0   latest, latest stable
+v0.4.2   
+`, ts.Stdout.String())
+}